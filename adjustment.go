@@ -0,0 +1,70 @@
+package calendar
+
+import "github.com/edgelaboratories/date"
+
+// Adjustment defines how a date which is not active is rolled to an
+// active date, also known as business day convention.
+type Adjustment string
+
+const (
+	// Unadjusted leaves the date unchanged.
+	Unadjusted Adjustment = "Unadjusted"
+	// Following rolls the date to the first active date after it.
+	Following Adjustment = "Following"
+	// ModifiedFollowing rolls the date to the first active date after it,
+	// unless it belongs to the next month, in which case the date is
+	// rolled to the latest active date before it.
+	ModifiedFollowing Adjustment = "ModifiedFollowing"
+	// Preceding rolls the date to the latest active date before it.
+	Preceding Adjustment = "Preceding"
+	// ModifiedPreceding rolls the date to the latest active date before it,
+	// unless it belongs to the previous month, in which case the date is
+	// rolled to the first active date after it.
+	ModifiedPreceding Adjustment = "ModifiedPreceding"
+	// Nearest rolls the date to the closest active date, the first active
+	// date after it being preferred in case of tie.
+	Nearest Adjustment = "Nearest"
+)
+
+// Adjust rolls the input date to an active date according to the
+// adjustment convention. Active dates are left unchanged, as well as
+// all dates for unknown conventions.
+func (c *Calendar) Adjust(d date.Date, adjustment Adjustment) date.Date {
+	if c.IsActive(d) {
+		return d
+	}
+
+	switch adjustment {
+	case Following:
+		return c.Next(d)
+
+	case ModifiedFollowing:
+		if following := c.Next(d); following.Month() == d.Month() {
+			return following
+		}
+
+		return c.LatestBefore(d)
+
+	case Preceding:
+		return c.LatestBefore(d)
+
+	case ModifiedPreceding:
+		if preceding := c.LatestBefore(d); preceding.Month() == d.Month() {
+			return preceding
+		}
+
+		return c.Next(d)
+
+	case Nearest:
+		following, preceding := c.Next(d), c.LatestBefore(d)
+		if following.Sub(d) <= d.Sub(preceding) {
+			return following
+		}
+
+		return preceding
+
+	case Unadjusted:
+	}
+
+	return d
+}
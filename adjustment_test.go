@@ -0,0 +1,65 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgelaboratories/date"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Calendar_Adjust(t *testing.T) {
+	t.Parallel()
+
+	var (
+		calendar = New(BusinessDays)
+		// Dates range from Thursday to Monday, across a month end.
+		thursday = date.New(2021, time.July, 29)
+		friday   = date.New(2021, time.July, 30)
+		saturday = date.New(2021, time.July, 31)
+		sunday   = date.New(2021, time.August, 1)
+		monday   = date.New(2021, time.August, 2)
+	)
+
+	for _, tc := range []struct {
+		name       string
+		date       date.Date
+		adjustment Adjustment
+		expected   date.Date
+	}{
+		{"unadjusted", saturday, Unadjusted, saturday},
+		{"unknown", saturday, Adjustment("Unknown"), saturday},
+		{"active", thursday, Following, thursday},
+		{"following/saturday", saturday, Following, monday},
+		{"following/sunday", sunday, Following, monday},
+		{"modified following/saturday", saturday, ModifiedFollowing, friday},
+		{"modified following/sunday", sunday, ModifiedFollowing, monday},
+		{"preceding/saturday", saturday, Preceding, friday},
+		{"preceding/sunday", sunday, Preceding, friday},
+		{"modified preceding/saturday", saturday, ModifiedPreceding, friday},
+		{"modified preceding/sunday", sunday, ModifiedPreceding, monday},
+		{"nearest/saturday", saturday, Nearest, friday},
+		{"nearest/sunday", sunday, Nearest, monday},
+	} {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, calendar.Adjust(tc.date, tc.adjustment))
+		})
+	}
+}
+
+func Test_Calendar_Adjust_Holidays(t *testing.T) {
+	t.Parallel()
+
+	calendar := NewWithHolidays(easterHolidays2021...)
+
+	// Good Friday and Easter Monday 2021 are holidays: Saturday is
+	// closer to Thursday and Sunday is closer to Tuesday.
+	assert.Equal(t, date.New(2021, time.April, 6), calendar.Adjust(date.New(2021, time.April, 4), Nearest))
+	assert.Equal(t, date.New(2021, time.April, 1), calendar.Adjust(date.New(2021, time.April, 3), Nearest))
+	assert.Equal(t, date.New(2021, time.April, 6), calendar.Adjust(date.New(2021, time.April, 2), Following))
+	assert.Equal(t, date.New(2021, time.April, 1), calendar.Adjust(date.New(2021, time.April, 5), Preceding))
+}
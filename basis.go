@@ -0,0 +1,84 @@
+package calendar
+
+import "errors"
+
+// ErrInvalidBasis is returned for negative annualisation bases.
+var ErrInvalidBasis = errors.New("calendar: invalid basis")
+
+// Basis is the number of days in a year used to annualise
+// numbers of active days.
+type Basis int
+
+const (
+	// ActualBasis annualises the active days of each calendar year by the
+	// actual number of active days in that year, see ActualYearFraction.
+	ActualBasis Basis = 0
+	// Basis252 is the usual number of trading days in a year.
+	Basis252 Basis = 252
+	// Basis256 is a number of trading days in a year, as a power of two.
+	Basis256 Basis = 256
+	// Basis260 is the number of weekdays in a 52-week year.
+	Basis260 Basis = 260
+	// Basis365 is the number of days in a non-leap year.
+	Basis365 Basis = 365
+)
+
+// actualBasisCalendar is implemented by calendars which may use the
+// actual basis.
+type actualBasisCalendar interface {
+	hasActualBasis() bool
+}
+
+// hasActualBasis returns true if the input calendar uses the actual basis.
+func hasActualBasis(counter DayCounter) bool {
+	c, ok := find[actualBasisCalendar](counter)
+
+	return ok && c.hasActualBasis()
+}
+
+// basisCalendar is a calendar whose active days are the ones of a base
+// calendar, with another annualisation basis.
+type basisCalendar struct {
+	DayCounter
+	basis Basis
+}
+
+// NewWithBasis returns a calendar with the same active days as the input
+// calendar, whose DaysInYear is the input basis. With the ActualBasis,
+// DaysInYear remains the one of the input calendar, but YearFraction is
+// computed as ActualYearFraction.
+// Such calendars can't be described, and ErrInvalidBasis is returned
+// for negative bases.
+func NewWithBasis(calendar *Calendar, basis Basis) (*Calendar, error) {
+	if basis < 0 {
+		return nil, ErrInvalidBasis
+	}
+
+	return &Calendar{&basisCalendar{calendar.DayCounter, basis}}, nil
+}
+
+// DaysInYear returns the basis of the calendar, or the standard year
+// duration of the base calendar for the actual basis.
+func (c basisCalendar) DaysInYear() int {
+	if c.basis == ActualBasis {
+		return c.DayCounter.DaysInYear()
+	}
+
+	return int(c.basis)
+}
+
+// hasActualBasis returns true if the calendar uses the actual basis.
+func (c basisCalendar) hasActualBasis() bool {
+	return c.basis == ActualBasis
+}
+
+// unwrap returns the base calendar.
+func (c basisCalendar) unwrap() DayCounter {
+	return c.DayCounter
+}
+
+// describe returns ErrNotDescribable, as descriptions don't
+// include the basis.
+func (c basisCalendar) describe() (Description, error) {
+	return Description{}, ErrNotDescribable
+}
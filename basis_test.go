@@ -0,0 +1,95 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgelaboratories/date"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewWithBasis(t *testing.T) {
+	t.Parallel()
+
+	var (
+		from = date.New(2021, time.December, 24)
+		to   = date.New(2022, time.January, 7)
+	)
+
+	for _, tc := range []struct {
+		basis      Basis
+		daysInYear int
+		fraction   float64
+	}{
+		{Basis252, 252, 10.0 / 252},
+		{Basis256, 256, 10.0 / 256},
+		{Basis260, 260, 10.0 / 260},
+		{Basis365, 365, 10.0 / 365},
+		{Basis(250), 250, 10.0 / 250},
+		// 2021 has 261 weekdays, 2022 has 260 weekdays.
+		{ActualBasis, 252, 5.0/261 + 5.0/260},
+	} {
+		calendar, err := NewWithBasis(New(BusinessDays), tc.basis)
+		require.NoError(t, err)
+
+		assert.Equal(t, BusinessDays, calendar.Convention())
+		assert.Equal(t, tc.daysInYear, calendar.DaysInYear())
+		assert.Equal(t, 10, calendar.DaysBetween(from, to))
+		assert.InDelta(t, tc.fraction, calendar.YearFraction(from, to), 1e-12)
+
+		// The basis is kept by indexed calendars.
+		indexed := NewIndexed(calendar, from, to)
+		assert.Equal(t, tc.daysInYear, indexed.DaysInYear())
+		assert.InDelta(t, tc.fraction, indexed.YearFraction(from, to), 1e-12)
+
+		_, err = calendar.Describe()
+		assert.ErrorIs(t, err, ErrNotDescribable)
+	}
+
+	_, err := NewWithBasis(New(BusinessDays), Basis(-1))
+	assert.ErrorIs(t, err, ErrInvalidBasis)
+}
+
+// Test_NewWithBasis_Wrapped registers a convention, hence it doesn't
+// run in parallel, see registerTest.
+func Test_NewWithBasis_Wrapped(t *testing.T) {
+	var (
+		from       = date.New(2024, time.January, 1)
+		to         = date.New(2025, time.January, 1)
+		holiday    = date.New(2024, time.April, 10)
+		convention = Convention("Test_NewWithBasis_Wrapped")
+	)
+
+	actual, err := NewWithBasis(New(BusinessDays), ActualBasis)
+	require.NoError(t, err)
+
+	registerTest(t, convention, func() *Calendar {
+		return actual
+	})
+
+	described, err := Description{Convention: convention, Holidays: []date.Date{holiday}}.Calendar()
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		name     string
+		calendar *Calendar
+	}{
+		{"registered", New(convention)},
+		{"described with holidays", described},
+		{"half days", NewWithHalfDays(actual, holiday)},
+		{"indexed", NewIndexed(described, from, to)},
+		{"joint", newTestJoint(t, AllActive, actual, described)},
+	} {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.True(t, hasActualBasis(tc.calendar.DayCounter))
+			assert.InDelta(t, tc.calendar.ActualYearFraction(from, to), tc.calendar.YearFraction(from, to), 1e-12)
+		})
+	}
+
+	assert.False(t, hasActualBasis(newTestJoint(t, AllActive, actual, New(BusinessDays)).DayCounter))
+}
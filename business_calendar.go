@@ -160,3 +160,8 @@ func addWeekendDays(current date.Date, nbDaysLeft, days int) int {
 
 	return nbDaysLeft
 }
+
+// describe returns the description of the calendar.
+func (c businessCalendar) describe() (Description, error) {
+	return Description{Convention: BusinessDays}, nil
+}
@@ -1,9 +1,14 @@
 package calendar
 
-import "github.com/edgelaboratories/date"
+import (
+	"time"
 
-// dayCounter defines the properties of a calendar.
-type dayCounter interface {
+	"github.com/edgelaboratories/date"
+)
+
+// DayCounter defines the properties of a calendar.
+// It can be implemented to define custom calendars, see NewFrom.
+type DayCounter interface {
 	// Convention returns the calendar convention.
 	Convention() Convention
 	// IsActive returns true if the input date is active.
@@ -13,22 +18,58 @@ type dayCounter interface {
 	// DaysBetween computes the number of active dates between
 	// from (excluded) and to (included).
 	DaysBetween(from, to date.Date) int
-	// add adds an input number of active days to the input origin date.
+	// Add adds an input number of active days to the input origin date.
 	// The days parameter is allowed to be negative.
 	// This method is idempotent for zero-days shifts.
 	Add(origin date.Date, days int) date.Date
 }
 
+// wrapper is implemented by calendars built on top of a base calendar,
+// such as calendars with additional holidays. They inherit the optional
+// behaviours of their base calendar, such as weekends or half days,
+// unless they implement them.
+type wrapper interface {
+	// unwrap returns the base calendar.
+	unwrap() DayCounter
+}
+
+// find returns the first calendar implementing T among the input calendar
+// and the calendars it wraps, and whether there is any.
+func find[T any](counter DayCounter) (T, bool) {
+	for {
+		if t, ok := counter.(T); ok {
+			return t, true
+		}
+
+		w, ok := counter.(wrapper)
+		if !ok {
+			var zero T
+			return zero, false
+		}
+
+		counter = w.unwrap()
+	}
+}
+
 // Calendar exposes functions to manipulate dates with respect to a calendar.
 type Calendar struct {
-	dayCounter
+	DayCounter
 }
 
-// New returns a calendar based on the specified input convention.
+// New returns a calendar based on the specified input convention,
+// either built-in or registered.
+// Unsupported conventions fall back to BusinessDays, see NewStrict
+// to reject them instead.
 func New(convention Convention) *Calendar {
+	if factory, ok := lookup(convention); ok {
+		return &Calendar{newNamedCalendar(convention, factory())}
+	}
+
 	switch convention {
 	case CalendarDays:
 		return &Calendar{newPhysicalCalendar()}
+	case NYSE, LSE, TARGET2, TSE, EuronextParis:
+		return &Calendar{indexedMarketCalendars[convention]()}
 	case BusinessDays:
 		fallthrough
 
@@ -37,6 +78,88 @@ func New(convention Convention) *Calendar {
 	}
 }
 
+// NewStrict returns a calendar based on the specified input convention.
+// As opposed to New, an UnknownConventionError is returned for
+// unsupported conventions.
+func NewStrict(convention Convention) (*Calendar, error) {
+	if !convention.isSupported() {
+		return nil, &UnknownConventionError{convention}
+	}
+
+	return New(convention), nil
+}
+
+// NewFrom returns a calendar based on the input day counter, which
+// may be a custom implementation. The calendartest package provides
+// a conformance test suite for such implementations.
+func NewFrom(counter DayCounter) *Calendar {
+	if calendar, ok := counter.(*Calendar); ok {
+		return calendar
+	}
+
+	return &Calendar{counter}
+}
+
+// NewWithHolidays returns a business-days calendar in which the input
+// holidays are not active, on top of weekends.
+func NewWithHolidays(holidays ...date.Date) *Calendar {
+	return &Calendar{newHolidayCalendar(BusinessDays, newBusinessCalendar(), holidays)}
+}
+
+// NewWithWeekend returns a business-days calendar whose weekend days
+// are the input weekdays, in which the input holidays are not active either.
+// An error is returned if every day of the week is a weekend day.
+func NewWithWeekend(weekend []time.Weekday, holidays ...date.Date) (*Calendar, error) {
+	base, err := newWeekendCalendar(weekend)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(holidays) == 0 {
+		return &Calendar{base}, nil
+	}
+
+	return &Calendar{newHolidayCalendar(BusinessDays, base, holidays)}, nil
+}
+
+// NewWithHolidayRules returns a business-days calendar in which the
+// holidays generated by the input rules between fromYear and toYear
+// (both included) are not active, on top of weekends.
+func NewWithHolidayRules(fromYear, toYear int, rules ...HolidayRule) *Calendar {
+	return NewWithHolidays(GenerateHolidays(fromYear, toYear, rules...)...)
+}
+
+// NewJoint returns a calendar combining the active days of the input
+// calendars according to the joint rule.
+// ErrNoActiveDays is returned if the joint calendar has no active day
+// within a year, such as for the AllActive combination of calendars
+// without working days in common, as it is assumed to have no active
+// days at all.
+func NewJoint(rule JointRule, calendar *Calendar, others ...*Calendar) (*Calendar, error) {
+	calendars := make([]DayCounter, 0, len(others)+1)
+
+	calendars = append(calendars, calendar.DayCounter)
+	for _, other := range others {
+		calendars = append(calendars, other.DayCounter)
+	}
+
+	joint, err := newJointCalendar(rule, calendars)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Calendar{joint}, nil
+}
+
+// NewIndexed returns a calendar with the same active days as the input
+// calendar, indexed between from and to (both included) so that DaysBetween
+// and Add are computed in constant time within this window, at the cost of
+// a few bytes of memory per day. Outside of the window, the input calendar
+// is used.
+func NewIndexed(calendar *Calendar, from, to date.Date) *Calendar {
+	return &Calendar{newIndexedCalendar(calendar.DayCounter, from, to)}
+}
+
 // LatestBefore returns the latest date before or equal to
 // an input date. As opposed to the input date, the output date
 // belongs to the calendar by construction.
@@ -6,6 +6,7 @@ import (
 
 	"github.com/edgelaboratories/date"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_New(t *testing.T) {
@@ -44,6 +45,11 @@ func Test_Calendar_Convention(t *testing.T) {
 	for _, convention := range []Convention{
 		CalendarDays,
 		BusinessDays,
+		NYSE,
+		LSE,
+		TARGET2,
+		TSE,
+		EuronextParis,
 	} {
 		assert.Equal(t, convention, New(convention).Convention())
 	}
@@ -136,68 +142,164 @@ func Test_Calendar_DaysBetween(t *testing.T) {
 	}
 }
 
+// benchmarkSpans are the spans over which calendars are benchmarked,
+// as a number of active days for shifts and an end date for counts.
+var benchmarkSpans = []struct {
+	name string
+	days int
+	to   date.Date
+}{
+	{"short", 5, date.New(2021, time.January, 8)},
+	{"long", 30 * 252, date.New(2051, time.January, 1)},
+}
+
+// benchmarkOrigin is the origin date of the benchmarks.
+var benchmarkOrigin = date.New(2021, time.January, 1)
+
 func Benchmark_Calendar_Add(b *testing.B) {
-	var (
-		origin = date.New(2021, time.January, 1)
-		days   = 365
-	)
+	for _, convention := range Conventions() {
+		c := New(convention)
 
-	for _, bc := range []struct {
-		name string
-		c    *Calendar
-	}{
-		{
-			"business",
-			New(BusinessDays),
-		},
-		{
-			"physical",
-			New(CalendarDays),
-		},
-	} {
-		bc := bc
+		for _, span := range benchmarkSpans {
+			span := span
+
+			b.Run(string(convention)+"/"+span.name, func(b *testing.B) {
+				b.ReportAllocs()
+				b.RunParallel(func(p *testing.PB) {
+					for p.Next() {
+						_ = c.Add(benchmarkOrigin, span.days)
+					}
+				})
+			})
+		}
+	}
+}
+
+func Benchmark_Calendar_DaysBetween(b *testing.B) {
+	for _, convention := range Conventions() {
+		c := New(convention)
 
-		b.Run(bc.name, func(b *testing.B) {
+		for _, span := range benchmarkSpans {
+			span := span
+
+			b.Run(string(convention)+"/"+span.name, func(b *testing.B) {
+				b.ReportAllocs()
+				b.RunParallel(func(p *testing.PB) {
+					for p.Next() {
+						_ = c.DaysBetween(benchmarkOrigin, span.to)
+					}
+				})
+			})
+		}
+	}
+}
+
+func Benchmark_Calendar_IsActive(b *testing.B) {
+	for _, convention := range Conventions() {
+		c := New(convention)
+
+		b.Run(string(convention), func(b *testing.B) {
+			b.ReportAllocs()
 			b.RunParallel(func(p *testing.PB) {
 				for p.Next() {
-					_ = bc.c.Add(origin, days)
+					_ = c.IsActive(benchmarkOrigin)
 				}
 			})
 		})
 	}
 }
 
-func Benchmark_Calendar_DaysBetween(b *testing.B) {
-	var (
-		from = date.New(2021, time.January, 1)
-		to   = date.New(2022, time.January, 1)
-	)
+func Benchmark_Calendar_LatestBefore(b *testing.B) {
+	for _, convention := range Conventions() {
+		c := New(convention)
 
-	for _, bc := range []struct {
-		name string
-		c    *Calendar
-	}{
-		{
-			"business",
-			New(BusinessDays),
-		},
-		{
-			"physical",
-			New(CalendarDays),
-		},
-	} {
-		bc := bc
+		b.Run(string(convention), func(b *testing.B) {
+			b.ReportAllocs()
+			b.RunParallel(func(p *testing.PB) {
+				for p.Next() {
+					_ = c.LatestBefore(benchmarkOrigin)
+				}
+			})
+		})
+	}
+}
+
+func Benchmark_Calendar_Next(b *testing.B) {
+	for _, convention := range Conventions() {
+		c := New(convention)
 
-		b.Run(bc.name, func(b *testing.B) {
+		b.Run(string(convention), func(b *testing.B) {
+			b.ReportAllocs()
 			b.RunParallel(func(p *testing.PB) {
 				for p.Next() {
-					_ = bc.c.DaysBetween(from, to)
+					_ = c.Next(benchmarkOrigin)
 				}
 			})
 		})
 	}
 }
 
+func Benchmark_Calendar_Previous(b *testing.B) {
+	for _, convention := range Conventions() {
+		c := New(convention)
+
+		b.Run(string(convention), func(b *testing.B) {
+			b.ReportAllocs()
+			b.RunParallel(func(p *testing.PB) {
+				for p.Next() {
+					_ = c.Previous(benchmarkOrigin)
+				}
+			})
+		})
+	}
+}
+
+// Test_Calendar_Allocations ensures the calendar methods don't allocate.
+// It doesn't run in parallel so that allocations are measured accurately.
+func Test_Calendar_Allocations(t *testing.T) {
+	weekend, err := NewWithWeekend([]time.Weekday{time.Friday, time.Saturday}, benchmarkOrigin.Add(3))
+	require.NoError(t, err)
+
+	calendars := map[string]*Calendar{
+		"holidays": NewWithHolidays(benchmarkOrigin.Add(3)),
+		"weekend":  weekend,
+		"indexed":  NewIndexed(NewWithHolidays(benchmarkOrigin.Add(3)), benchmarkOrigin, benchmarkOrigin.Add(365)),
+		"joint":    newTestJoint(t, AllActive, New(NYSE), New(LSE)),
+	}
+	for _, convention := range Conventions() {
+		calendars[string(convention)] = New(convention)
+	}
+
+	for name, c := range calendars {
+		for _, span := range benchmarkSpans {
+			for method, f := range map[string]func(){
+				"Add":          func() { _ = c.Add(benchmarkOrigin, span.days) },
+				"DaysBetween":  func() { _ = c.DaysBetween(benchmarkOrigin, span.to) },
+				"IsActive":     func() { _ = c.IsActive(span.to) },
+				"LatestBefore": func() { _ = c.LatestBefore(span.to) },
+				"Next":         func() { _ = c.Next(span.to) },
+				"Previous":     func() { _ = c.Previous(span.to) },
+				"DaysInYear":   func() { _ = c.DaysInYear() },
+			} {
+				assert.Zero(t, testing.AllocsPerRun(10, f), "%s/%s/%s", name, span.name, method)
+			}
+		}
+	}
+
+	var (
+		nyse = New(NYSE)
+		lse  = New(LSE)
+		tse  = New(TSE)
+	)
+
+	for method, f := range map[string]func(){
+		"SettlementDate": func() { _ = SettlementDate(benchmarkOrigin, 2, nyse, lse) },
+		"FXSpotDate":     func() { _ = FXSpotDate(benchmarkOrigin, 2, nyse, lse, tse) },
+	} {
+		assert.Zero(t, testing.AllocsPerRun(10, f), method)
+	}
+}
+
 func Test_Calendar_LatestBefore_Next_Previous(t *testing.T) {
 	t.Parallel()
 
@@ -284,3 +386,29 @@ func Test_Calendar_LatestBefore_Next_Previous(t *testing.T) {
 		})
 	}
 }
+
+func Test_NewStrict(t *testing.T) {
+	t.Parallel()
+
+	for _, convention := range Conventions() {
+		calendar, err := NewStrict(convention)
+		require.NoError(t, err)
+		assert.Equal(t, convention, calendar.Convention())
+	}
+
+	_, err := NewStrict("BusinesDays")
+
+	var unknown *UnknownConventionError
+
+	assert.ErrorAs(t, err, &unknown)
+}
+
+func Test_NewFrom(t *testing.T) {
+	t.Parallel()
+
+	calendar := NewFrom(newPhysicalCalendar())
+	assert.Equal(t, CalendarDays, calendar.Convention())
+
+	// Calendars are not wrapped twice.
+	assert.Same(t, calendar, NewFrom(calendar))
+}
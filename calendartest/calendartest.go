@@ -0,0 +1,99 @@
+// Package calendartest implements support for testing implementations
+// of the calendar.DayCounter interface.
+package calendartest
+
+import (
+	"testing"
+
+	"github.com/edgelaboratories/date"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/edgelaboratories/calendar"
+)
+
+// maxShift is the largest number of active days shifted by the checks.
+const maxShift = 256
+
+// Run checks that the input day counter behaves as a calendar, for dates
+// between from and to (both included). Shifts of up to 256 active days
+// are checked from the first week following from, so the range should
+// span at least that many active days.
+func Run(t *testing.T, counter calendar.DayCounter, from, to date.Date) {
+	t.Helper()
+
+	c := calendar.NewFrom(counter)
+
+	t.Run("days in year", func(t *testing.T) {
+		assert.Positive(t, c.DaysInYear())
+	})
+
+	t.Run("complete week", func(t *testing.T) {
+		for j := 0; j < 7; j++ {
+			current := from.Add(j)
+
+			for i := 1; i <= maxShift; i++ {
+				shifted := c.Add(current, i)
+				origin := c.Add(shifted, -i)
+
+				// Forward-shifted date by i days should not equal the current date.
+				assert.False(t, current.Equal(shifted))
+
+				// Shifted dates are active by construction.
+				assert.True(t, c.IsActive(shifted))
+				assert.True(t, c.IsActive(origin))
+
+				// Ensure between current/origin and shifted there are exactly i active days.
+				assert.Equal(t, i, c.DaysBetween(current, shifted))
+				assert.Equal(t, i, c.DaysBetween(origin, shifted))
+
+				// Ensure forward and backward shifts are consistent.
+				assert.True(t, c.Add(origin, i).Equal(shifted))
+				assert.True(t, c.Add(shifted, -i).Equal(origin))
+			}
+		}
+	})
+
+	t.Run("zero shift", func(t *testing.T) {
+		for current := from; !current.After(to); current = current.Add(1) {
+			latest := c.LatestBefore(current)
+
+			assert.True(t, c.IsActive(latest))
+			assert.False(t, latest.After(current))
+			assert.True(t, c.LatestBefore(latest).Equal(latest))
+			assert.True(t, !c.IsActive(current) || latest.Equal(current))
+		}
+	})
+
+	t.Run("closest active day", func(t *testing.T) {
+		for current := from; !current.After(to); current = current.Add(1) {
+			previous := c.LatestBefore(current)
+			next := c.Next(previous)
+
+			dayDiff := 1
+			if c.IsActive(current) {
+				dayDiff = 0
+				next = current
+			}
+
+			// When current is not active, it is assumed equal to the latest
+			// active day before it, so it always coincides with previous.
+			assert.Equal(t, 0, c.DaysBetween(previous, current))
+			// Daily difference between previous/current and next is equivalent.
+			assert.Equal(t, dayDiff, c.DaysBetween(current, next))
+			assert.Equal(t, dayDiff, c.DaysBetween(previous, next))
+			assert.True(t, dayDiff == 0 || c.Previous(next).Equal(previous))
+		}
+	})
+
+	t.Run("daily count", func(t *testing.T) {
+		for current := from; !current.After(to); current = current.Add(1) {
+			expected := 0
+			if c.IsActive(current) {
+				expected = 1
+			}
+
+			assert.Equal(t, expected, c.DaysBetween(current.Add(-1), current))
+			assert.Equal(t, -expected, c.DaysBetween(current, current.Add(-1)))
+		}
+	})
+}
@@ -0,0 +1,108 @@
+package calendartest_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgelaboratories/date"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgelaboratories/calendar"
+	"github.com/edgelaboratories/calendar/calendartest"
+)
+
+// evenDays is a custom calendar whose active days are the even
+// days of the month.
+type evenDays struct{}
+
+func (evenDays) Convention() calendar.Convention {
+	return "EvenDays"
+}
+
+func (evenDays) IsActive(d date.Date) bool {
+	return d.Day()%2 == 0
+}
+
+func (evenDays) DaysInYear() int {
+	return 182
+}
+
+func (c evenDays) DaysBetween(from, to date.Date) int {
+	if from.After(to) {
+		return -c.DaysBetween(to, from)
+	}
+
+	days := 0
+	for current := from.Add(1); !current.After(to); current = current.Add(1) {
+		if c.IsActive(current) {
+			days++
+		}
+	}
+
+	return days
+}
+
+func (c evenDays) Add(origin date.Date, days int) date.Date {
+	current := origin
+	for !c.IsActive(current) {
+		current = current.Add(-1)
+	}
+
+	shift := 1
+	if days < 0 {
+		shift, days = -1, -days
+	}
+
+	for days > 0 {
+		current = current.Add(shift)
+		if c.IsActive(current) {
+			days--
+		}
+	}
+
+	return current
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	var (
+		from = date.New(2017, time.January, 9)
+		to   = date.New(2020, time.December, 11)
+	)
+
+	weekend, err := calendar.NewWithWeekend([]time.Weekday{time.Friday, time.Saturday})
+	require.NoError(t, err)
+
+	joint, err := calendar.NewJoint(calendar.AllActive, calendar.New(calendar.NYSE), calendar.New(calendar.LSE))
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		name     string
+		calendar *calendar.Calendar
+	}{
+		{"custom", calendar.NewFrom(evenDays{})},
+		{"weekend", weekend},
+		{"indexed", calendar.NewIndexed(calendar.New(calendar.LSE), date.New(2018, time.June, 1), date.New(2019, time.June, 1))},
+		{"holidays", calendar.NewWithHolidays(date.New(2018, time.August, 31), date.New(2019, time.December, 25))},
+		{"joint", joint},
+	} {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			calendartest.Run(t, tc.calendar, from, to)
+		})
+	}
+
+	for _, convention := range calendar.Conventions() {
+		convention := convention
+
+		t.Run(string(convention), func(t *testing.T) {
+			t.Parallel()
+
+			calendartest.Run(t, calendar.New(convention), from, to)
+		})
+	}
+}
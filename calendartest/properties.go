@@ -0,0 +1,110 @@
+package calendartest
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/edgelaboratories/date"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/edgelaboratories/calendar"
+)
+
+// iterations is the number of random samples drawn per property.
+const iterations = 1000
+
+// generator draws random dates and shifts.
+type generator struct {
+	rand *rand.Rand
+	from date.Date
+	days int
+}
+
+func newGenerator(seed uint64, from, to date.Date) *generator {
+	return &generator{
+		rand: rand.New(rand.NewPCG(seed, seed)),
+		from: from,
+		days: to.Sub(from) + 1,
+	}
+}
+
+// date returns a random date between from and to (both included).
+func (g *generator) date() date.Date {
+	return g.from.Add(g.rand.IntN(g.days))
+}
+
+// shift returns a random number of days between -maxShift and maxShift.
+func (g *generator) shift() int {
+	return g.rand.IntN(2*maxShift+1) - maxShift
+}
+
+// RunProperties checks that the input day counter satisfies the algebraic
+// properties of a calendar, for random dates between from and to (both
+// included) and random shifts of up to 256 active days.
+// The random samples are fully determined by the input seed, which is
+// logged on failure so that it can be reproduced.
+func RunProperties(t *testing.T, counter calendar.DayCounter, from, to date.Date, seed uint64) {
+	t.Helper()
+
+	c := calendar.NewFrom(counter)
+
+	t.Cleanup(func() {
+		if t.Failed() {
+			t.Logf("calendartest: properties checked with seed %d", seed)
+		}
+	})
+
+	t.Run("latest before is idempotent", func(t *testing.T) {
+		g := newGenerator(seed, from, to)
+
+		for i := 0; i < iterations; i++ {
+			d := g.date()
+			latest := c.LatestBefore(d)
+
+			assert.True(t, c.IsActive(latest), "date %s", d)
+			assert.False(t, latest.After(d), "date %s", d)
+			assert.Equal(t, latest, c.LatestBefore(latest), "date %s", d)
+		}
+	})
+
+	t.Run("add is additive", func(t *testing.T) {
+		g := newGenerator(seed, from, to)
+
+		for i := 0; i < iterations; i++ {
+			d, n, m := c.LatestBefore(g.date()), g.shift(), g.shift()
+
+			assert.Equal(t, c.Add(d, n+m), c.Add(c.Add(d, n), m), "date %s, shifts %d and %d", d, n, m)
+		}
+	})
+
+	t.Run("days between inverts add", func(t *testing.T) {
+		g := newGenerator(seed, from, to)
+
+		for i := 0; i < iterations; i++ {
+			d, n := c.LatestBefore(g.date()), g.shift()
+
+			assert.Equal(t, n, c.DaysBetween(d, c.Add(d, n)), "date %s, shift %d", d, n)
+		}
+	})
+
+	t.Run("days between is antisymmetric", func(t *testing.T) {
+		g := newGenerator(seed, from, to)
+
+		for i := 0; i < iterations; i++ {
+			d1, d2 := g.date(), g.date()
+
+			assert.Equal(t, -c.DaysBetween(d2, d1), c.DaysBetween(d1, d2), "dates %s and %s", d1, d2)
+		}
+	})
+
+	t.Run("days between is additive", func(t *testing.T) {
+		g := newGenerator(seed, from, to)
+
+		for i := 0; i < iterations; i++ {
+			d1, d2, d3 := g.date(), g.date(), g.date()
+
+			assert.Equal(t, c.DaysBetween(d1, d3), c.DaysBetween(d1, d2)+c.DaysBetween(d2, d3),
+				"dates %s, %s and %s", d1, d2, d3)
+		}
+	})
+}
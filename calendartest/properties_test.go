@@ -0,0 +1,58 @@
+package calendartest_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgelaboratories/date"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgelaboratories/calendar"
+	"github.com/edgelaboratories/calendar/calendartest"
+)
+
+func TestRunProperties(t *testing.T) {
+	t.Parallel()
+
+	var (
+		from = date.New(2000, time.January, 1)
+		to   = date.New(2030, time.December, 31)
+		// The seed is fixed so that the samples are the same on every run.
+		seed = uint64(20240410)
+	)
+
+	weekend, err := calendar.NewWithWeekend([]time.Weekday{time.Friday, time.Saturday})
+	require.NoError(t, err)
+
+	joint, err := calendar.NewJoint(calendar.AnyActive, calendar.New(calendar.TSE), calendar.New(calendar.TARGET2))
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		name     string
+		calendar *calendar.Calendar
+	}{
+		{"custom", calendar.NewFrom(evenDays{})},
+		{"weekend", weekend},
+		{"indexed", calendar.NewIndexed(calendar.New(calendar.LSE), date.New(2018, time.June, 1), date.New(2019, time.June, 1))},
+		{"holidays", calendar.NewWithHolidays(date.New(2018, time.August, 31), date.New(2019, time.December, 25))},
+		{"joint", joint},
+	} {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			calendartest.RunProperties(t, tc.calendar, from, to, seed)
+		})
+	}
+
+	for _, convention := range calendar.Conventions() {
+		convention := convention
+
+		t.Run(string(convention), func(t *testing.T) {
+			t.Parallel()
+
+			calendartest.RunProperties(t, calendar.New(convention), from, to, seed)
+		})
+	}
+}
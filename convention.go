@@ -1,5 +1,10 @@
 package calendar
 
+import (
+	"fmt"
+	"strings"
+)
+
 // Convention defines the calendar convention.
 type Convention string
 
@@ -10,4 +15,97 @@ const (
 	// CalendarDays defines a convention that uses a nominal ISO calendar.
 	// All days, including weekends, are considered active.
 	CalendarDays Convention = "CalendarDays"
+	// NYSE uses the New York Stock Exchange trading days.
+	NYSE Convention = "NYSE"
+	// LSE uses the London Stock Exchange trading days.
+	LSE Convention = "LSE"
+	// TARGET2 uses the settlement days of the Trans-European
+	// Automated Real-time Gross settlement Express Transfer system.
+	TARGET2 Convention = "TARGET2"
+	// TSE uses the Tokyo Stock Exchange trading days.
+	TSE Convention = "TSE"
+	// EuronextParis uses the Euronext Paris trading days.
+	EuronextParis Convention = "EuronextParis"
 )
+
+// conventions lists the built-in conventions.
+var conventions = []Convention{
+	BusinessDays,
+	CalendarDays,
+	NYSE,
+	LSE,
+	TARGET2,
+	TSE,
+	EuronextParis,
+}
+
+// UnknownConventionError is returned when a convention is not supported.
+type UnknownConventionError struct {
+	Convention Convention
+}
+
+// Error implements the error interface.
+func (e *UnknownConventionError) Error() string {
+	return fmt.Sprintf("calendar: unknown convention %q", string(e.Convention))
+}
+
+// Conventions returns all the conventions supported by New,
+// that is the built-in ones followed by the registered ones.
+func Conventions() []Convention {
+	return append(append([]Convention{}, conventions...), registered()...)
+}
+
+// ParseConvention returns the supported convention matching the input
+// value, compared case-insensitively.
+// An UnknownConventionError is returned if no convention matches.
+func ParseConvention(value string) (Convention, error) {
+	value = strings.TrimSpace(value)
+
+	for _, convention := range Conventions() {
+		if strings.EqualFold(value, string(convention)) {
+			return convention, nil
+		}
+	}
+
+	return "", &UnknownConventionError{Convention(value)}
+}
+
+// isSupported returns true if the convention is supported by New.
+func (c Convention) isSupported() bool {
+	if isBuiltIn(c) {
+		return true
+	}
+
+	_, ok := lookup(c)
+
+	return ok
+}
+
+// isBuiltIn returns true if the convention is a built-in one.
+func isBuiltIn(c Convention) bool {
+	for _, convention := range conventions {
+		if c == convention {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (c Convention) MarshalText() ([]byte, error) {
+	return []byte(c), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The input is parsed by means of ParseConvention.
+func (c *Convention) UnmarshalText(data []byte) error {
+	convention, err := ParseConvention(string(data))
+	if err != nil {
+		return err
+	}
+
+	*c = convention
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package calendar
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Convention_MarshalText(t *testing.T) {
+	t.Parallel()
+
+	data, err := json.Marshal([]Convention{BusinessDays, NYSE})
+	require.NoError(t, err)
+	assert.JSONEq(t, `["BusinessDays", "NYSE"]`, string(data))
+}
+
+func Test_Convention_UnmarshalText(t *testing.T) {
+	t.Parallel()
+
+	var conventions []Convention
+
+	require.NoError(t, json.Unmarshal([]byte(`["CalendarDays", "TARGET2"]`), &conventions))
+	assert.Equal(t, []Convention{CalendarDays, TARGET2}, conventions)
+
+	var convention Convention
+
+	err := json.Unmarshal([]byte(`"BusinesDays"`), &convention)
+
+	var unknown *UnknownConventionError
+
+	require.ErrorAs(t, err, &unknown)
+	assert.Equal(t, Convention("BusinesDays"), unknown.Convention)
+	assert.EqualError(t, err, `calendar: unknown convention "BusinesDays"`)
+}
+
+func Test_Conventions(t *testing.T) {
+	t.Parallel()
+
+	all := Conventions()
+
+	assert.Contains(t, all, BusinessDays)
+	assert.Contains(t, all, CalendarDays)
+	assert.Contains(t, all, NYSE)
+
+	for _, convention := range all {
+		assert.Equal(t, convention, New(convention).Convention())
+	}
+
+	// The returned slice is a copy.
+	all[0] = "Modified"
+	assert.NotEqual(t, all, Conventions())
+}
+
+func Test_ParseConvention(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		value    string
+		expected Convention
+	}{
+		{"BusinessDays", BusinessDays},
+		{"calendardays", CalendarDays},
+		{" nyse ", NYSE},
+		{"Target2", TARGET2},
+	} {
+		convention, err := ParseConvention(tc.value)
+		require.NoError(t, err)
+		assert.Equal(t, tc.expected, convention)
+	}
+
+	_, err := ParseConvention("BusinesDays")
+
+	var unknown *UnknownConventionError
+
+	require.ErrorAs(t, err, &unknown)
+	assert.Equal(t, Convention("BusinesDays"), unknown.Convention)
+}
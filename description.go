@@ -0,0 +1,136 @@
+package calendar
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/edgelaboratories/date"
+)
+
+// ErrNotDescribable is returned when a calendar can't be described.
+var ErrNotDescribable = errors.New("calendar: calendar can't be described")
+
+// Weekday is a day of the week, encoded as its English name.
+type Weekday time.Weekday
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (w Weekday) MarshalText() ([]byte, error) {
+	if w < Weekday(time.Sunday) || w > Weekday(time.Saturday) {
+		return nil, fmt.Errorf("calendar: invalid weekday %d", w)
+	}
+
+	return []byte(time.Weekday(w).String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Weekday names are case-insensitive and may be abbreviated to
+// their first three letters.
+func (w *Weekday) UnmarshalText(data []byte) error {
+	name := string(data)
+
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if strings.EqualFold(name, day.String()) || strings.EqualFold(name, day.String()[:3]) {
+			*w = Weekday(day)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("calendar: invalid weekday %q", name)
+}
+
+// Description is a serializable description of a calendar,
+// made of a convention, and optional weekend days, holidays and half days.
+// An empty Weekend stands for the default Saturday and Sunday, while
+// NoWeekend describes calendars without weekend days.
+type Description struct {
+	Convention Convention  `json:"convention" yaml:"convention"`
+	Weekend    []Weekday   `json:"weekend,omitempty" yaml:"weekend,omitempty"`
+	NoWeekend  bool        `json:"noWeekend,omitempty" yaml:"no_weekend,omitempty"`
+	Holidays   []date.Date `json:"holidays,omitempty" yaml:"holidays,omitempty"`
+	HalfDays   []date.Date `json:"halfDays,omitempty" yaml:"half_days,omitempty"`
+}
+
+// describer is implemented by calendars which can be described.
+type describer interface {
+	describe() (Description, error)
+}
+
+// Calendar returns the calendar matching the description.
+// The convention defaults to BusinessDays. Weekend days can only be
+// customized for the BusinessDays convention, either with Weekend or
+// NoWeekend but not both, and holidays can't be added to the CalendarDays
+// convention.
+func (d Description) Calendar() (*Calendar, error) {
+	calendar, err := d.calendar()
+	if err != nil || len(d.HalfDays) == 0 {
+		return calendar, err
+	}
+
+	return NewWithHalfDays(calendar, d.HalfDays...), nil
+}
+
+// calendar returns the calendar matching the description,
+// regardless of half days.
+func (d Description) calendar() (*Calendar, error) {
+	convention := d.Convention
+	if convention == "" {
+		convention = BusinessDays
+	}
+
+	if !convention.isSupported() {
+		return nil, &UnknownConventionError{convention}
+	}
+
+	if d.NoWeekend && len(d.Weekend) != 0 {
+		return nil, errors.New("calendar: weekend days can't be set along with no weekend")
+	}
+
+	customWeekend := len(d.Weekend) != 0 || d.NoWeekend
+
+	if !customWeekend && len(d.Holidays) == 0 {
+		return New(convention), nil
+	}
+
+	if convention == BusinessDays {
+		if !customWeekend {
+			return NewWithHolidays(d.Holidays...), nil
+		}
+
+		weekend := make([]time.Weekday, 0, len(d.Weekend))
+		for _, w := range d.Weekend {
+			weekend = append(weekend, time.Weekday(w))
+		}
+
+		return NewWithWeekend(weekend, d.Holidays...)
+	}
+
+	if customWeekend {
+		return nil, fmt.Errorf("calendar: weekend can't be customized for convention %q", string(convention))
+	}
+
+	if convention == CalendarDays {
+		return nil, fmt.Errorf("calendar: holidays can't be added to convention %q", string(convention))
+	}
+
+	base := New(convention).DayCounter
+
+	return &Calendar{newHolidayCalendar(convention, base, d.Holidays)}, nil
+}
+
+// Describe returns the description of the calendar.
+// ErrNotDescribable is returned for calendars which can't be
+// described, such as joint calendars.
+func (c *Calendar) Describe() (Description, error) {
+	return describe(c.DayCounter)
+}
+
+// describe returns the description of the input calendar.
+func describe(counter DayCounter) (Description, error) {
+	if d, ok := find[describer](counter); ok {
+		return d.describe()
+	}
+
+	return Description{}, ErrNotDescribable
+}
@@ -0,0 +1,293 @@
+package calendar
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/edgelaboratories/date"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func Test_Weekday_Text(t *testing.T) {
+	t.Parallel()
+
+	var weekdays []Weekday
+
+	require.NoError(t, json.Unmarshal([]byte(`["Friday", "sat", "SUNDAY"]`), &weekdays))
+	assert.Equal(t, []Weekday{Weekday(time.Friday), Weekday(time.Saturday), Weekday(time.Sunday)}, weekdays)
+
+	data, err := json.Marshal(weekdays)
+	require.NoError(t, err)
+	assert.JSONEq(t, `["Friday", "Saturday", "Sunday"]`, string(data))
+
+	assert.Error(t, json.Unmarshal([]byte(`["Weekend"]`), &weekdays))
+
+	_, err = Weekday(7).MarshalText()
+	assert.Error(t, err)
+}
+
+func Test_Description_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	description := Description{
+		Convention: BusinessDays,
+		Weekend:    []Weekday{Weekday(time.Friday), Weekday(time.Saturday)},
+		Holidays:   []date.Date{date.New(2024, time.April, 10), date.New(2024, time.June, 16)},
+		HalfDays:   []date.Date{date.New(2024, time.December, 24)},
+	}
+
+	t.Run("json", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := json.Marshal(description)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"convention": "BusinessDays",
+			"weekend": ["Friday", "Saturday"],
+			"holidays": ["2024-04-10", "2024-06-16"],
+			"halfDays": ["2024-12-24"]
+		}`, string(data))
+
+		var actual Description
+
+		require.NoError(t, json.Unmarshal(data, &actual))
+		assert.Equal(t, description, actual)
+	})
+
+	t.Run("yaml", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := yaml.Marshal(description)
+		require.NoError(t, err)
+		assert.YAMLEq(t, `
+convention: BusinessDays
+weekend: [Friday, Saturday]
+holidays: ["2024-04-10", "2024-06-16"]
+half_days: ["2024-12-24"]
+`, string(data))
+
+		var actual Description
+
+		require.NoError(t, yaml.Unmarshal(data, &actual))
+		assert.Equal(t, description, actual)
+	})
+
+	t.Run("no weekend", func(t *testing.T) {
+		t.Parallel()
+
+		holiday := date.New(2024, time.April, 10)
+
+		calendar, err := NewWithWeekend(nil, holiday)
+		require.NoError(t, err)
+
+		description, err := calendar.Describe()
+		require.NoError(t, err)
+		assert.Equal(t, Description{
+			Convention: BusinessDays,
+			NoWeekend:  true,
+			Holidays:   []date.Date{holiday},
+		}, description)
+
+		data, err := json.Marshal(description)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"convention": "BusinessDays",
+			"noWeekend": true,
+			"holidays": ["2024-04-10"]
+		}`, string(data))
+
+		var actual Description
+
+		require.NoError(t, json.Unmarshal(data, &actual))
+		assert.Equal(t, description, actual)
+
+		data, err = yaml.Marshal(description)
+		require.NoError(t, err)
+		assert.YAMLEq(t, `
+convention: BusinessDays
+no_weekend: true
+holidays: ["2024-04-10"]
+`, string(data))
+
+		actual = Description{}
+
+		require.NoError(t, yaml.Unmarshal(data, &actual))
+		assert.Equal(t, description, actual)
+
+		described, err := actual.Calendar()
+		require.NoError(t, err)
+		assert.True(t, described.IsActive(date.New(2024, time.April, 13)))
+		assert.False(t, described.IsActive(holiday))
+	})
+
+	t.Run("unknown convention", func(t *testing.T) {
+		t.Parallel()
+
+		var actual Description
+
+		assert.Error(t, json.Unmarshal([]byte(`{"convention": "Unknown"}`), &actual))
+		assert.Error(t, yaml.Unmarshal([]byte(`convention: Unknown`), &actual))
+	})
+}
+
+func Test_Description_Calendar(t *testing.T) {
+	t.Parallel()
+
+	var (
+		holiday = date.New(2024, time.April, 10)
+		friday  = date.New(2024, time.April, 12)
+	)
+
+	for _, tc := range []struct {
+		name        string
+		description Description
+		convention  Convention
+		inactive    []date.Date
+	}{
+		{
+			"default",
+			Description{},
+			BusinessDays,
+			[]date.Date{date.New(2024, time.April, 13)},
+		},
+		{
+			"holidays",
+			Description{Holidays: []date.Date{holiday}},
+			BusinessDays,
+			[]date.Date{holiday, date.New(2024, time.April, 13)},
+		},
+		{
+			"weekend",
+			Description{
+				Convention: BusinessDays,
+				Weekend:    []Weekday{Weekday(time.Friday), Weekday(time.Saturday)},
+				Holidays:   []date.Date{holiday},
+			},
+			BusinessDays,
+			[]date.Date{holiday, friday},
+		},
+		{
+			"market",
+			Description{Convention: NYSE},
+			NYSE,
+			[]date.Date{date.New(2024, time.July, 4)},
+		},
+		{
+			"market with holidays",
+			Description{Convention: NYSE, Holidays: []date.Date{holiday}},
+			NYSE,
+			[]date.Date{holiday, date.New(2024, time.July, 4)},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			calendar, err := tc.description.Calendar()
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.convention, calendar.Convention())
+			assert.True(t, calendar.IsActive(date.New(2024, time.April, 11)))
+
+			for _, d := range tc.inactive {
+				assert.False(t, calendar.IsActive(d))
+			}
+		})
+	}
+}
+
+func Test_Description_Calendar_Errors(t *testing.T) {
+	t.Parallel()
+
+	for _, description := range []Description{
+		{Convention: "Unknown"},
+		{Convention: CalendarDays, Holidays: []date.Date{date.New(2024, time.April, 10)}},
+		{Convention: NYSE, Weekend: []Weekday{Weekday(time.Friday)}},
+		{Weekend: []Weekday{0, 1, 2, 3, 4, 5, 6}},
+		{NoWeekend: true, Weekend: []Weekday{Weekday(time.Friday)}},
+		{Convention: NYSE, NoWeekend: true},
+	} {
+		_, err := description.Calendar()
+		assert.Error(t, err)
+	}
+}
+
+func Test_Calendar_Describe(t *testing.T) {
+	t.Parallel()
+
+	holidays := []date.Date{date.New(2024, time.April, 10)}
+
+	for _, tc := range []struct {
+		name     string
+		calendar func() (*Calendar, error)
+		expected Description
+	}{
+		{
+			"business",
+			func() (*Calendar, error) { return New(BusinessDays), nil },
+			Description{Convention: BusinessDays},
+		},
+		{
+			"physical",
+			func() (*Calendar, error) { return New(CalendarDays), nil },
+			Description{Convention: CalendarDays},
+		},
+		{
+			"market",
+			func() (*Calendar, error) { return New(TSE), nil },
+			Description{Convention: TSE},
+		},
+		{
+			"holidays",
+			func() (*Calendar, error) { return NewWithHolidays(holidays...), nil },
+			Description{Convention: BusinessDays, Holidays: holidays},
+		},
+		{
+			"weekend",
+			func() (*Calendar, error) {
+				return NewWithWeekend([]time.Weekday{time.Saturday, time.Friday}, holidays...)
+			},
+			Description{
+				Convention: BusinessDays,
+				Weekend:    []Weekday{Weekday(time.Friday), Weekday(time.Saturday)},
+				Holidays:   holidays,
+			},
+		},
+		{
+			"market with holidays",
+			func() (*Calendar, error) {
+				return Description{Convention: LSE, Holidays: holidays}.Calendar()
+			},
+			Description{Convention: LSE, Holidays: holidays},
+		},
+		{
+			"file",
+			func() (*Calendar, error) {
+				calendars, err := ReadHolidaysCSV(strings.NewReader("2024-04-10,XNYS\n"))
+				return calendars["XNYS"], err
+			},
+			Description{Convention: BusinessDays, Holidays: holidays},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			calendar, err := tc.calendar()
+			require.NoError(t, err)
+
+			description, err := calendar.Describe()
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, description)
+		})
+	}
+
+	_, err := newTestJoint(t, AllActive, New(NYSE), New(LSE)).Describe()
+	assert.ErrorIs(t, err, ErrNotDescribable)
+}
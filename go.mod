@@ -1,14 +1,14 @@
 module github.com/edgelaboratories/calendar
 
-go 1.21
+go 1.23
 
 require (
 	github.com/edgelaboratories/date v1.0.0
 	github.com/stretchr/testify v1.9.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -0,0 +1,168 @@
+package calendar
+
+import (
+	"sort"
+
+	"github.com/edgelaboratories/date"
+)
+
+// holidayCalendar is a calendar whose active days are the ones of
+// a base calendar, except for an additional set of holidays.
+type holidayCalendar struct {
+	convention Convention
+	// base is the calendar defining the regular active days,
+	// typically the weekend rule.
+	base DayCounter
+	// holidays is the sorted list of holidays which are active
+	// according to the base calendar.
+	holidays []date.Date
+	// descriptions are the descriptions of the holidays, if known,
+	// such as the ones read from holiday files.
+	descriptions map[date.Date]string
+}
+
+func newHolidayCalendar(convention Convention, base DayCounter, holidays []date.Date) *holidayCalendar {
+	// Only keep the holidays falling on active days of the base
+	// calendar, without duplicates, so they can be counted directly.
+	sorted := make([]date.Date, 0, len(holidays))
+	for _, holiday := range holidays {
+		if base.IsActive(holiday) {
+			sorted = append(sorted, holiday)
+		}
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Before(sorted[j])
+	})
+
+	unique := sorted[:0]
+	for _, holiday := range sorted {
+		if len(unique) == 0 || !holiday.Equal(unique[len(unique)-1]) {
+			unique = append(unique, holiday)
+		}
+	}
+
+	return &holidayCalendar{
+		convention: convention,
+		base:       base,
+		holidays:   unique,
+	}
+}
+
+// Convention returns the calendar convention.
+func (c holidayCalendar) Convention() Convention {
+	return c.convention
+}
+
+// IsActive returns true if the input date is active according
+// to the base calendar and is not a holiday.
+func (c holidayCalendar) IsActive(date date.Date) bool {
+	return c.base.IsActive(date) && !c.isHoliday(date)
+}
+
+// DaysInYear returns the standard year duration of the base calendar.
+func (c holidayCalendar) DaysInYear() int {
+	return c.base.DaysInYear()
+}
+
+// Add adds an input number of active days to the input origin date.
+// The days parameter is allowed to be negative.
+// This method is idempotent when a zero-days shift is requested.
+func (c holidayCalendar) Add(origin date.Date, days int) date.Date {
+	current := c.latestBefore(origin)
+
+	// Shift the date on the base calendar, then shift it again by the
+	// number of holidays which have been skipped, until none is left.
+	// Each iteration only depends on the holidays found in the last
+	// shifted range, so the loop ends after a few steps.
+	for remaining := days; remaining != 0; {
+		next := c.base.Add(current, remaining)
+
+		if remaining > 0 {
+			remaining = c.holidaysBetween(current, next)
+		} else {
+			remaining = -c.holidaysBetween(next.Add(-1), current.Add(-1))
+		}
+
+		current = next
+	}
+
+	return current
+}
+
+// DaysBetween computes the number of active dates between
+// from (excluded) and to (included).
+func (c holidayCalendar) DaysBetween(from, to date.Date) int {
+	if from.After(to) {
+		return -c.DaysBetween(to, from)
+	}
+
+	return c.base.DaysBetween(from, to) - c.holidaysBetween(from, to)
+}
+
+// latestBefore returns the latest active date before or equal to
+// the input date.
+func (c holidayCalendar) latestBefore(origin date.Date) date.Date {
+	current := c.base.Add(origin, 0)
+	for c.isHoliday(current) {
+		current = c.base.Add(current, -1)
+	}
+
+	return current
+}
+
+// isHoliday returns true if the input date is one of the holidays.
+func (c holidayCalendar) isHoliday(date date.Date) bool {
+	i := sort.Search(len(c.holidays), func(i int) bool {
+		return !c.holidays[i].Before(date)
+	})
+
+	return i < len(c.holidays) && c.holidays[i].Equal(date)
+}
+
+// holidaysBetween returns the number of holidays between
+// from (excluded) and to (included).
+// The from parameter is supposed not to be after to.
+func (c holidayCalendar) holidaysBetween(from, to date.Date) int {
+	return c.holidaysUntil(to) - c.holidaysUntil(from)
+}
+
+// holidaysUntil returns the number of holidays before or equal
+// to the input date.
+func (c holidayCalendar) holidaysUntil(date date.Date) int {
+	return sort.Search(len(c.holidays), func(i int) bool {
+		return c.holidays[i].After(date)
+	})
+}
+
+// unwrap returns the base calendar.
+func (c holidayCalendar) unwrap() DayCounter {
+	return c.base
+}
+
+// holidayDescription returns the description of the input holiday,
+// falling back to the one of the base calendar.
+func (c holidayCalendar) holidayDescription(d date.Date) string {
+	if description, ok := c.descriptions[d]; ok {
+		return description
+	}
+
+	return holidayDescription(c.base, d)
+}
+
+// describe returns the description of the calendar.
+// Market calendars are described by their convention only.
+func (c holidayCalendar) describe() (Description, error) {
+	if _, ok := marketCalendars[c.convention]; ok && c.base.Convention() != c.convention {
+		return Description{Convention: c.convention}, nil
+	}
+
+	description, err := describe(c.base)
+	if err != nil {
+		return Description{}, err
+	}
+
+	description.Holidays = append(append([]date.Date{}, description.Holidays...), c.holidays...)
+
+	return description, nil
+}
@@ -0,0 +1,222 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgelaboratories/date"
+	"github.com/stretchr/testify/assert"
+)
+
+// easterHolidays2021 are holidays around Easter 2021,
+// with Good Friday on April 2nd and Easter Monday on April 5th.
+var easterHolidays2021 = []date.Date{
+	date.New(2021, time.April, 2),
+	date.New(2021, time.April, 5),
+}
+
+func Test_newHolidayCalendar(t *testing.T) {
+	t.Parallel()
+
+	calendar := newHolidayCalendar(BusinessDays, newBusinessCalendar(), []date.Date{
+		date.New(2021, time.April, 5),
+		date.New(2021, time.April, 2),
+		date.New(2021, time.April, 5),
+		// Saturday, already inactive.
+		date.New(2021, time.April, 3),
+	})
+
+	assert.Equal(t, easterHolidays2021, calendar.holidays)
+	assert.Equal(t, BusinessDays, calendar.Convention())
+	assert.Equal(t, 252, calendar.DaysInYear())
+}
+
+func Test_holidayCalendar_IsActive(t *testing.T) {
+	t.Parallel()
+
+	calendar := newHolidayCalendar(BusinessDays, newBusinessCalendar(), easterHolidays2021)
+
+	for _, tc := range []struct {
+		date     date.Date
+		expected bool
+	}{
+		{
+			date.New(2021, time.April, 1),
+			true,
+		},
+		{
+			date.New(2021, time.April, 2),
+			false,
+		},
+		{
+			date.New(2021, time.April, 3),
+			false,
+		},
+		{
+			date.New(2021, time.April, 4),
+			false,
+		},
+		{
+			date.New(2021, time.April, 5),
+			false,
+		},
+		{
+			date.New(2021, time.April, 6),
+			true,
+		},
+	} {
+		assert.Equal(t, tc.expected, calendar.IsActive(tc.date))
+	}
+}
+
+func Test_holidayCalendar_Add(t *testing.T) {
+	t.Parallel()
+
+	calendar := newHolidayCalendar(BusinessDays, newBusinessCalendar(), easterHolidays2021)
+
+	for _, tc := range []struct {
+		origin   date.Date
+		days     int
+		expected date.Date
+	}{
+		{
+			date.New(2021, time.April, 1),
+			0,
+			date.New(2021, time.April, 1),
+		},
+		{
+			date.New(2021, time.April, 5),
+			0,
+			date.New(2021, time.April, 1),
+		},
+		{
+			date.New(2021, time.April, 1),
+			1,
+			date.New(2021, time.April, 6),
+		},
+		{
+			date.New(2021, time.April, 3),
+			1,
+			date.New(2021, time.April, 6),
+		},
+		{
+			date.New(2021, time.April, 6),
+			-1,
+			date.New(2021, time.April, 1),
+		},
+		{
+			date.New(2021, time.March, 31),
+			3,
+			date.New(2021, time.April, 7),
+		},
+		{
+			date.New(2021, time.April, 7),
+			-3,
+			date.New(2021, time.March, 31),
+		},
+		{
+			date.New(2021, time.March, 29),
+			10,
+			date.New(2021, time.April, 14),
+		},
+	} {
+		assert.Equal(t, tc.expected, calendar.Add(tc.origin, tc.days))
+	}
+}
+
+func Test_holidayCalendar_DaysBetween(t *testing.T) {
+	t.Parallel()
+
+	calendar := newHolidayCalendar(BusinessDays, newBusinessCalendar(), easterHolidays2021)
+
+	for _, tc := range []struct {
+		from     date.Date
+		to       date.Date
+		expected int
+	}{
+		{
+			date.New(2021, time.April, 1),
+			date.New(2021, time.April, 2),
+			0,
+		},
+		{
+			date.New(2021, time.April, 1),
+			date.New(2021, time.April, 6),
+			1,
+		},
+		{
+			date.New(2021, time.April, 2),
+			date.New(2021, time.April, 6),
+			1,
+		},
+		{
+			date.New(2021, time.March, 29),
+			date.New(2021, time.April, 14),
+			10,
+		},
+		{
+			date.New(2021, time.April, 6),
+			date.New(2021, time.April, 7),
+			1,
+		},
+	} {
+		assert.Equal(t, tc.expected, calendar.DaysBetween(tc.from, tc.to))
+		assert.Equal(t, -tc.expected, calendar.DaysBetween(tc.to, tc.from))
+	}
+}
+
+func Test_holidayCalendar_ConsistencyChecks(t *testing.T) {
+	t.Parallel()
+
+	// Mark every other Wednesday of 2021 as a holiday, as well as
+	// a full week, so that shifts run across many holidays.
+	holidays := []date.Date{}
+	for current := date.New(2021, time.January, 6); current.Year() == 2021; current = current.Add(14) {
+		holidays = append(holidays, current)
+	}
+
+	for current := date.New(2021, time.August, 2); current.Before(date.New(2021, time.August, 9)); current = current.Add(1) {
+		holidays = append(holidays, current)
+	}
+
+	calendar := newHolidayCalendar(BusinessDays, newBusinessCalendar(), holidays)
+
+	origin := date.New(2020, time.December, 28)
+	for j := 0; j < 7; j++ {
+		current := origin.Add(j)
+
+		for i := 1; i <= 256; i++ {
+			to := calendar.Add(current, i)
+			from := calendar.Add(to, -i)
+
+			assert.False(t, current.Equal(to))
+			assert.True(t, calendar.IsActive(to))
+			assert.True(t, calendar.IsActive(from))
+			assert.Equal(t, i, calendar.DaysBetween(current, to))
+			assert.Equal(t, i, calendar.DaysBetween(from, to))
+			assert.True(t, calendar.Add(from, i).Equal(to))
+		}
+	}
+
+	// Compare to a day-by-day count of active days.
+	count := 0
+	for current := origin.Add(1); current.Year() < 2022; current = current.Add(1) {
+		if calendar.IsActive(current) {
+			count++
+		}
+
+		assert.Equal(t, count, calendar.DaysBetween(origin, current))
+	}
+}
+
+func Test_NewWithHolidays(t *testing.T) {
+	t.Parallel()
+
+	calendar := NewWithHolidays(easterHolidays2021...)
+
+	assert.Equal(t, BusinessDays, calendar.Convention())
+	assert.Equal(t, date.New(2021, time.April, 1), calendar.LatestBefore(date.New(2021, time.April, 5)))
+	assert.Equal(t, date.New(2021, time.April, 6), calendar.Next(date.New(2021, time.April, 1)))
+	assert.Equal(t, date.New(2021, time.April, 1), calendar.Previous(date.New(2021, time.April, 6)))
+	assert.Equal(t, 1, calendar.DaysBetween(date.New(2021, time.April, 1), date.New(2021, time.April, 6)))
+}
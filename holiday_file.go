@@ -0,0 +1,191 @@
+package calendar
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/edgelaboratories/date"
+)
+
+// csvHeader is the header of the holiday CSV files.
+var csvHeader = []string{"date", "market", "description"}
+
+// holidayDescriber is implemented by calendars knowing the descriptions
+// of their holidays, such as the ones read from holiday files.
+type holidayDescriber interface {
+	holidayDescription(d date.Date) string
+}
+
+// holidayDescription returns the description of the input holiday
+// in the input calendar, or an empty string if it is unknown.
+func holidayDescription(counter DayCounter, d date.Date) string {
+	if h, ok := find[holidayDescriber](counter); ok {
+		return h.holidayDescription(d)
+	}
+
+	return ""
+}
+
+// ReadHolidaysCSV reads holidays from CSV records made of an ISO date,
+// a market code and an optional description, and returns a business-days
+// calendar per market, whose convention is the market code.
+// Descriptions are kept, so that WriteHolidaysCSV writes them back; the
+// first non-empty one is kept for holidays listed several times.
+// A leading header record and lines starting with '#' are skipped.
+// Market codes matching a supported convention regardless of case, such
+// as NYSE, are rejected, since the calendars read from files differ from
+// the built-in or registered ones: vendor codes such as XNYS can be used.
+// Since market codes aren't conventions, the calendars are described as
+// BusinessDays calendars with holidays: neither the market code nor the
+// holiday descriptions are kept by Describe.
+func ReadHolidaysCSV(r io.Reader) (map[Convention]*Calendar, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	reader.Comment = '#'
+
+	holidays := map[Convention][]date.Date{}
+	descriptions := map[Convention]map[date.Date]string{}
+
+	for first := true; ; first = false {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("calendar: reading holidays: %w", err)
+		}
+
+		if first && strings.EqualFold(strings.TrimSpace(record[0]), csvHeader[0]) {
+			continue
+		}
+
+		line, _ := reader.FieldPos(0)
+
+		if len(record) < 2 {
+			return nil, fmt.Errorf("calendar: holidays line %d: missing market", line)
+		}
+
+		holiday, err := date.ParseISO(strings.TrimSpace(record[0]))
+		if err != nil {
+			return nil, fmt.Errorf("calendar: holidays line %d: invalid date %q", line, record[0])
+		}
+
+		market := Convention(strings.TrimSpace(record[1]))
+		if market == "" {
+			return nil, fmt.Errorf("calendar: holidays line %d: missing market", line)
+		}
+
+		if _, err := ParseConvention(string(market)); err == nil {
+			return nil, fmt.Errorf("calendar: holidays line %d: market %q clashes with a supported convention", line, market)
+		}
+
+		holidays[market] = append(holidays[market], holiday)
+
+		if descriptions[market] == nil {
+			descriptions[market] = map[date.Date]string{}
+		}
+
+		if len(record) > 2 && descriptions[market][holiday] == "" {
+			descriptions[market][holiday] = strings.TrimSpace(record[2])
+		}
+	}
+
+	calendars := make(map[Convention]*Calendar, len(holidays))
+	for market, dates := range holidays {
+		calendar := newHolidayCalendar(market, newBusinessCalendar(), dates)
+		calendar.descriptions = descriptions[market]
+
+		calendars[market] = &Calendar{calendar}
+	}
+
+	return calendars, nil
+}
+
+// WriteHolidaysCSV writes the holidays of the input calendars between
+// fromYear and toYear (both included) as CSV records made of an ISO date,
+// the calendar convention as market code and the holiday description,
+// preceded by a header. Descriptions are only known for calendars read
+// by ReadHolidaysCSV, and are left empty otherwise.
+func WriteHolidaysCSV(w io.Writer, fromYear, toYear int, calendars ...*Calendar) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write(csvHeader); err != nil {
+		return fmt.Errorf("calendar: writing holidays: %w", err)
+	}
+
+	for _, calendar := range calendars {
+		market := string(calendar.Convention())
+
+		for _, holiday := range calendar.Holidays(yearRange(fromYear, toYear)) {
+			record := []string{holiday.String(), market, holidayDescription(calendar.DayCounter, holiday)}
+			if err := writer.Write(record); err != nil {
+				return fmt.Errorf("calendar: writing holidays: %w", err)
+			}
+		}
+	}
+
+	writer.Flush()
+
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("calendar: writing holidays: %w", err)
+	}
+
+	return nil
+}
+
+// ReadHolidaysText reads holidays written as one ISO date per line.
+// Blank lines and lines starting with '#' are skipped.
+func ReadHolidaysText(r io.Reader) ([]date.Date, error) {
+	holidays := []date.Date{}
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		holiday, err := date.ParseISO(text)
+		if err != nil {
+			return nil, fmt.Errorf("calendar: holidays line %d: invalid date %q", line, text)
+		}
+
+		holidays = append(holidays, holiday)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("calendar: reading holidays: %w", err)
+	}
+
+	return holidays, nil
+}
+
+// WriteHolidaysText writes the holidays of the input calendar between
+// fromYear and toYear (both included) as one ISO date per line.
+func WriteHolidaysText(w io.Writer, calendar *Calendar, fromYear, toYear int) error {
+	writer := bufio.NewWriter(w)
+
+	for _, holiday := range calendar.Holidays(yearRange(fromYear, toYear)) {
+		if _, err := fmt.Fprintln(writer, holiday.String()); err != nil {
+			return fmt.Errorf("calendar: writing holidays: %w", err)
+		}
+	}
+
+	if err := writer.Flush(); err != nil {
+		return fmt.Errorf("calendar: writing holidays: %w", err)
+	}
+
+	return nil
+}
+
+// yearRange returns the first day of fromYear and the last day of toYear.
+func yearRange(fromYear, toYear int) (date.Date, date.Date) {
+	return date.New(fromYear, time.January, 1), date.New(toYear, time.December, 31)
+}
@@ -0,0 +1,152 @@
+package calendar
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/edgelaboratories/date"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ReadHolidaysCSV(t *testing.T) {
+	t.Parallel()
+
+	data := `Date,Market,Description
+# Vendor export
+2021-12-24, XNYS, Christmas (observed)
+2022-01-17,XNYS,"Martin Luther King, Jr. Day"
+2021-12-27,XLON,Christmas (substitute)
+2021-12-28,XLON
+`
+
+	calendars, err := ReadHolidaysCSV(strings.NewReader(data))
+	require.NoError(t, err)
+	require.Len(t, calendars, 2)
+
+	nyse := calendars["XNYS"]
+	require.NotNil(t, nyse)
+	assert.Equal(t, Convention("XNYS"), nyse.Convention())
+	assert.Equal(t, date.New(2021, time.December, 23), nyse.Previous(date.New(2021, time.December, 27)))
+
+	lse := calendars["XLON"]
+	require.NotNil(t, lse)
+	assert.Equal(t, []date.Date{
+		date.New(2021, time.December, 27),
+		date.New(2021, time.December, 28),
+	}, lse.Holidays(date.New(2021, time.January, 1), date.New(2022, time.December, 31)))
+}
+
+func Test_ReadHolidaysCSV_Errors(t *testing.T) {
+	t.Parallel()
+
+	for _, data := range []string{
+		"2021-12-24\n",
+		"2021-12-24,\n",
+		"24/12/2021,XNYS\n",
+		"2021-12-24,\"XNYS\n",
+		"date,market\nDate,XNYS\n",
+		"2021-12-24,NYSE\n",
+		"2021-12-24,businessdays\n",
+	} {
+		_, err := ReadHolidaysCSV(strings.NewReader(data))
+		assert.Error(t, err, data)
+	}
+}
+
+func Test_WriteHolidaysCSV(t *testing.T) {
+	t.Parallel()
+
+	var buffer bytes.Buffer
+
+	require.NoError(t, WriteHolidaysCSV(&buffer, 2021, 2021, New(NYSE), NewWithHolidays(date.New(2021, time.August, 16))))
+
+	assert.Equal(t, `date,market,description
+2021-01-01,NYSE,
+2021-01-18,NYSE,
+2021-02-15,NYSE,
+2021-04-02,NYSE,
+2021-05-31,NYSE,
+2021-07-05,NYSE,
+2021-09-06,NYSE,
+2021-11-25,NYSE,
+2021-12-24,NYSE,
+2021-08-16,BusinessDays,
+`, buffer.String())
+
+}
+
+func Test_HolidaysCSV_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	data := `date,market,description
+2021-12-24,XNYS,Christmas (observed)
+2022-01-17,XNYS,"Martin Luther King, Jr. Day"
+2021-12-27,XLON,Christmas (substitute)
+2021-12-28,XLON,
+`
+
+	calendars, err := ReadHolidaysCSV(strings.NewReader(data))
+	require.NoError(t, err)
+
+	var buffer bytes.Buffer
+
+	require.NoError(t, WriteHolidaysCSV(&buffer, 2021, 2022, calendars["XNYS"], calendars["XLON"]))
+	assert.Equal(t, data, buffer.String())
+
+	// Descriptions are kept by wrapping calendars.
+	buffer.Reset()
+
+	require.NoError(t, WriteHolidaysCSV(&buffer, 2021, 2021, NewIndexed(calendars["XNYS"], date.New(2021, time.January, 1), date.New(2021, time.December, 31))))
+	assert.Equal(t, "date,market,description\n2021-12-24,XNYS,Christmas (observed)\n", buffer.String())
+}
+
+func Test_ReadHolidaysCSV_Describe(t *testing.T) {
+	t.Parallel()
+
+	holiday := date.New(2021, time.December, 24)
+
+	calendars, err := ReadHolidaysCSV(strings.NewReader("2021-12-24,XNYS,Christmas (observed)\n"))
+	require.NoError(t, err)
+
+	// The market code and the holiday descriptions are lost.
+	description, err := calendars["XNYS"].Describe()
+	require.NoError(t, err)
+	assert.Equal(t, Description{Convention: BusinessDays, Holidays: []date.Date{holiday}}, description)
+
+	calendar, err := description.Calendar()
+	require.NoError(t, err)
+	assert.Equal(t, BusinessDays, calendar.Convention())
+
+	var buffer bytes.Buffer
+
+	require.NoError(t, WriteHolidaysCSV(&buffer, 2021, 2021, calendar))
+	assert.Equal(t, "date,market,description\n2021-12-24,BusinessDays,\n", buffer.String())
+}
+
+func Test_ReadHolidaysText(t *testing.T) {
+	t.Parallel()
+
+	holidays, err := ReadHolidaysText(strings.NewReader("# Holidays\n2021-12-24\n\n  2021-12-31  \n"))
+	require.NoError(t, err)
+	assert.Equal(t, []date.Date{date.New(2021, time.December, 24), date.New(2021, time.December, 31)}, holidays)
+
+	_, err = ReadHolidaysText(strings.NewReader("2021-12-24\nChristmas\n"))
+	assert.Error(t, err)
+}
+
+func Test_WriteHolidaysText(t *testing.T) {
+	t.Parallel()
+
+	var buffer bytes.Buffer
+
+	calendar := NewWithHolidays(date.New(2021, time.December, 24), date.New(2021, time.December, 31), date.New(2022, time.January, 3))
+	require.NoError(t, WriteHolidaysText(&buffer, calendar, 2021, 2021))
+	assert.Equal(t, "2021-12-24\n2021-12-31\n", buffer.String())
+
+	holidays, err := ReadHolidaysText(&buffer)
+	require.NoError(t, err)
+	assert.Equal(t, calendar.Holidays(date.New(2021, time.January, 1), date.New(2021, time.December, 31)), holidays)
+}
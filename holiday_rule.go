@@ -0,0 +1,271 @@
+package calendar
+
+import (
+	"sort"
+	"time"
+
+	"github.com/edgelaboratories/date"
+)
+
+// HolidayRule defines how holidays are generated for a given year.
+type HolidayRule interface {
+	// Holidays returns the holidays generated by the rule for
+	// the input year, if any.
+	Holidays(year int) []date.Date
+}
+
+// GenerateHolidays returns the sorted list of holidays generated by
+// the input rules and falling between fromYear and toYear (both included).
+// Since observance may move a holiday to an adjacent year, such as
+// January 1st observed on the previous December 31st, the holidays
+// generated for the years around the range are also considered, and
+// the holidays falling outside of the range are dropped.
+func GenerateHolidays(fromYear, toYear int, rules ...HolidayRule) []date.Date {
+	holidays := []date.Date{}
+
+	if fromYear > toYear {
+		return holidays
+	}
+
+	for year := fromYear - 1; year <= toYear+1; year++ {
+		for _, rule := range rules {
+			for _, holiday := range rule.Holidays(year) {
+				if y := holiday.Year(); y >= fromYear && y <= toYear {
+					holidays = append(holidays, holiday)
+				}
+			}
+		}
+	}
+
+	sort.Slice(holidays, func(i, j int) bool {
+		return holidays[i].Before(holidays[j])
+	})
+
+	return holidays
+}
+
+// FixedDate is a holiday occurring every year on the same day,
+// such as Christmas on December 25th.
+type FixedDate struct {
+	Month time.Month
+	Day   int
+}
+
+// Holidays returns the holiday of the input year.
+// No holiday is returned if the day doesn't exist in the year,
+// such as February 29th in non-leap years.
+func (r FixedDate) Holidays(year int) []date.Date {
+	holiday := date.New(year, r.Month, r.Day)
+	if holiday.Month() != r.Month {
+		return nil
+	}
+
+	return []date.Date{holiday}
+}
+
+// NthWeekday is a holiday occurring on the nth weekday of a month,
+// such as the third Monday of January.
+type NthWeekday struct {
+	// N is the 1-based rank of the weekday in the month.
+	N       int
+	Weekday time.Weekday
+	Month   time.Month
+}
+
+// Holidays returns the holiday of the input year.
+// No holiday is returned if the month has less than N such weekdays.
+func (r NthWeekday) Holidays(year int) []date.Date {
+	if r.N < 1 {
+		return nil
+	}
+
+	first := date.New(year, r.Month, 1)
+	offset := (int(r.Weekday) - int(first.Weekday()) + 7) % 7
+
+	holiday := first.Add(offset + 7*(r.N-1))
+	if holiday.Month() != r.Month {
+		return nil
+	}
+
+	return []date.Date{holiday}
+}
+
+// LastWeekday is a holiday occurring on the last weekday of a month,
+// such as the last Monday of May.
+type LastWeekday struct {
+	Weekday time.Weekday
+	Month   time.Month
+}
+
+// Holidays returns the holiday of the input year.
+func (r LastWeekday) Holidays(year int) []date.Date {
+	last := date.New(year, r.Month+1, 0)
+	offset := (int(last.Weekday()) - int(r.Weekday) + 7) % 7
+
+	return []date.Date{last.Add(-offset)}
+}
+
+// EasterOffset is a holiday occurring a number of days after
+// Easter Sunday, such as Good Friday (-2) or Easter Monday (1).
+type EasterOffset struct {
+	Days int
+}
+
+// Holidays returns the holiday of the input year.
+func (r EasterOffset) Holidays(year int) []date.Date {
+	return []date.Date{Easter(year).Add(r.Days)}
+}
+
+// SpecificDates is a list of one-off holidays, such as
+// exceptional closures.
+type SpecificDates []date.Date
+
+// Holidays returns the dates belonging to the input year.
+func (r SpecificDates) Holidays(year int) []date.Date {
+	var holidays []date.Date
+
+	for _, holiday := range r {
+		if holiday.Year() == year {
+			holidays = append(holidays, holiday)
+		}
+	}
+
+	return holidays
+}
+
+// Years restricts a rule to the years between From and To
+// (both included). A zero bound is unlimited.
+type Years struct {
+	Rule     HolidayRule
+	From, To int
+}
+
+// Holidays returns the holidays of the underlying rule if
+// the input year is within bounds.
+func (r Years) Holidays(year int) []date.Date {
+	if (r.From != 0 && year < r.From) || (r.To != 0 && year > r.To) {
+		return nil
+	}
+
+	return r.Rule.Holidays(year)
+}
+
+// ExceptYears disables a rule during some years, typically when
+// a holiday has exceptionally been moved.
+type ExceptYears struct {
+	Rule  HolidayRule
+	Years []int
+}
+
+// Holidays returns the holidays of the underlying rule unless
+// the input year is excluded.
+func (r ExceptYears) Holidays(year int) []date.Date {
+	for _, excluded := range r.Years {
+		if excluded == year {
+			return nil
+		}
+	}
+
+	return r.Rule.Holidays(year)
+}
+
+// Observance adjusts a holiday to the day on which it is observed.
+type Observance func(date.Date) date.Date
+
+// Observed is a holiday rule whose holidays are adjusted by an observance,
+// such as a Saturday holiday being observed on the Friday before.
+type Observed struct {
+	Rule       HolidayRule
+	Observance Observance
+}
+
+// Holidays returns the observed holidays of the underlying rule.
+func (r Observed) Holidays(year int) []date.Date {
+	holidays := r.Rule.Holidays(year)
+
+	observed := make([]date.Date, 0, len(holidays))
+	for _, holiday := range holidays {
+		observed = append(observed, r.Observance(holiday))
+	}
+
+	return observed
+}
+
+var (
+	// NearestWeekday observes Saturday holidays on Friday
+	// and Sunday holidays on Monday.
+	NearestWeekday Observance = func(holiday date.Date) date.Date {
+		switch holiday.Weekday() {
+		case time.Saturday:
+			return holiday.Add(-1)
+
+		case time.Sunday:
+			return holiday.Add(1)
+
+		case time.Monday,
+			time.Tuesday,
+			time.Wednesday,
+			time.Thursday,
+			time.Friday:
+		}
+
+		return holiday
+	}
+
+	// SundayToMonday observes Sunday holidays on Monday.
+	SundayToMonday Observance = func(holiday date.Date) date.Date {
+		if holiday.Weekday() == time.Sunday {
+			return holiday.Add(1)
+		}
+
+		return holiday
+	}
+
+	// WeekendToMonday observes weekend holidays on the next Monday.
+	WeekendToMonday Observance = func(holiday date.Date) date.Date {
+		switch holiday.Weekday() {
+		case time.Saturday:
+			return holiday.Add(2)
+
+		case time.Sunday:
+			return holiday.Add(1)
+
+		case time.Monday,
+			time.Tuesday,
+			time.Wednesday,
+			time.Thursday,
+			time.Friday:
+		}
+
+		return holiday
+	}
+
+	// WeekendPlusTwoDays observes weekend holidays two days later, that is
+	// Saturday on Monday and Sunday on Tuesday. This is the usual observance
+	// of two consecutive holidays such as Christmas and Boxing Day.
+	WeekendPlusTwoDays Observance = func(holiday date.Date) date.Date {
+		if w := holiday.Weekday(); w == time.Saturday || w == time.Sunday {
+			return holiday.Add(2)
+		}
+
+		return holiday
+	}
+)
+
+// Easter returns the date of Easter Sunday of the input year
+// in the Gregorian calendar.
+func Easter(year int) date.Date {
+	// Anonymous Gregorian algorithm (Meeus/Jones/Butcher).
+	a := year % 19
+	b, c := year/100, year%100
+	d, e := b/4, b%4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i, k := c/4, c%4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	n := h + l - 7*m + 114
+
+	return date.New(year, time.Month(n/31), n%31+1)
+}
@@ -0,0 +1,188 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgelaboratories/date"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Easter(t *testing.T) {
+	t.Parallel()
+
+	for _, expected := range []date.Date{
+		date.New(1961, time.April, 2),
+		date.New(2000, time.April, 23),
+		date.New(2008, time.March, 23),
+		date.New(2019, time.April, 21),
+		date.New(2021, time.April, 4),
+		date.New(2024, time.March, 31),
+		date.New(2038, time.April, 25),
+	} {
+		assert.Equal(t, expected, Easter(expected.Year()))
+	}
+}
+
+func Test_HolidayRule_Holidays(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		rule     HolidayRule
+		year     int
+		expected []date.Date
+	}{
+		{
+			"fixed date",
+			FixedDate{time.December, 25},
+			2021,
+			[]date.Date{date.New(2021, time.December, 25)},
+		},
+		{
+			"fixed date/non-leap year",
+			FixedDate{time.February, 29},
+			2021,
+			nil,
+		},
+		{
+			"nth weekday/first",
+			NthWeekday{1, time.Monday, time.September},
+			2021,
+			[]date.Date{date.New(2021, time.September, 6)},
+		},
+		{
+			"nth weekday/third",
+			NthWeekday{3, time.Monday, time.January},
+			2021,
+			[]date.Date{date.New(2021, time.January, 18)},
+		},
+		{
+			"nth weekday/fifth missing",
+			NthWeekday{5, time.Monday, time.February},
+			2021,
+			nil,
+		},
+		{
+			"last weekday",
+			LastWeekday{time.Monday, time.May},
+			2021,
+			[]date.Date{date.New(2021, time.May, 31)},
+		},
+		{
+			"last weekday/december",
+			LastWeekday{time.Friday, time.December},
+			2021,
+			[]date.Date{date.New(2021, time.December, 31)},
+		},
+		{
+			"easter offset/good friday",
+			EasterOffset{-2},
+			2021,
+			[]date.Date{date.New(2021, time.April, 2)},
+		},
+		{
+			"specific dates",
+			SpecificDates{date.New(2001, time.September, 11), date.New(2012, time.October, 29)},
+			2012,
+			[]date.Date{date.New(2012, time.October, 29)},
+		},
+		{
+			"years/within",
+			Years{FixedDate{time.June, 19}, 2022, 0},
+			2022,
+			[]date.Date{date.New(2022, time.June, 19)},
+		},
+		{
+			"years/before",
+			Years{FixedDate{time.June, 19}, 2022, 0},
+			2021,
+			nil,
+		},
+		{
+			"years/after",
+			Years{FixedDate{time.June, 19}, 0, 2020},
+			2021,
+			nil,
+		},
+		{
+			"except years",
+			ExceptYears{LastWeekday{time.Monday, time.May}, []int{2021}},
+			2021,
+			nil,
+		},
+		{
+			"observed/nearest weekday saturday",
+			Observed{FixedDate{time.July, 4}, NearestWeekday},
+			2020,
+			[]date.Date{date.New(2020, time.July, 3)},
+		},
+		{
+			"observed/nearest weekday sunday",
+			Observed{FixedDate{time.July, 4}, NearestWeekday},
+			2021,
+			[]date.Date{date.New(2021, time.July, 5)},
+		},
+		{
+			"observed/sunday to monday",
+			Observed{FixedDate{time.January, 1}, SundayToMonday},
+			2022,
+			[]date.Date{date.New(2022, time.January, 1)},
+		},
+		{
+			"observed/weekend to monday",
+			Observed{FixedDate{time.January, 1}, WeekendToMonday},
+			2022,
+			[]date.Date{date.New(2022, time.January, 3)},
+		},
+		{
+			"observed/weekend plus two days",
+			Observed{FixedDate{time.December, 26}, WeekendPlusTwoDays},
+			2021,
+			[]date.Date{date.New(2021, time.December, 28)},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, tc.rule.Holidays(tc.year))
+		})
+	}
+}
+
+func Test_GenerateHolidays(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []date.Date{
+		date.New(2020, time.April, 10),
+		date.New(2020, time.December, 25),
+		date.New(2021, time.April, 2),
+		date.New(2021, time.December, 25),
+	}, GenerateHolidays(2020, 2021, FixedDate{time.December, 25}, EasterOffset{-2}))
+
+	assert.Empty(t, GenerateHolidays(2021, 2020, FixedDate{time.December, 25}))
+
+	// January 1st, 2022 is a Saturday observed on December 31st, 2021.
+	newYear := Observed{FixedDate{time.January, 1}, NearestWeekday}
+
+	assert.Empty(t, GenerateHolidays(2022, 2022, newYear))
+	assert.Equal(t, []date.Date{
+		date.New(2021, time.January, 1),
+		date.New(2021, time.December, 31),
+	}, GenerateHolidays(2021, 2021, newYear))
+}
+
+func Test_NewWithHolidayRules(t *testing.T) {
+	t.Parallel()
+
+	calendar := NewWithHolidayRules(2021, 2021,
+		EasterOffset{-2},
+		EasterOffset{1},
+	)
+
+	assert.False(t, calendar.IsActive(date.New(2021, time.April, 2)))
+	assert.False(t, calendar.IsActive(date.New(2021, time.April, 5)))
+	assert.Equal(t, date.New(2021, time.April, 6), calendar.Next(date.New(2021, time.April, 1)))
+}
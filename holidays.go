@@ -0,0 +1,40 @@
+package calendar
+
+import (
+	"time"
+
+	"github.com/edgelaboratories/date"
+)
+
+// weekender is implemented by calendars which know their weekend days.
+type weekender interface {
+	isWeekend(date date.Date) bool
+}
+
+// isWeekend returns true if the input date is a weekend day of the
+// input calendar, assumed to be Saturday or Sunday if unknown.
+func isWeekend(counter DayCounter, d date.Date) bool {
+	if w, ok := find[weekender](counter); ok {
+		return w.isWeekend(d)
+	}
+
+	w := d.Weekday()
+
+	return w == time.Saturday || w == time.Sunday
+}
+
+// Holidays returns the sorted inactive dates between from and to (both
+// included) which are not weekend days. Weekend days are assumed to be
+// Saturday and Sunday for calendars which don't define them, such as
+// custom calendars.
+func (c *Calendar) Holidays(from, to date.Date) []date.Date {
+	holidays := []date.Date{}
+
+	for current := from; !current.After(to); current = current.Add(1) {
+		if !c.IsActive(current) && !isWeekend(c.DayCounter, current) {
+			holidays = append(holidays, current)
+		}
+	}
+
+	return holidays
+}
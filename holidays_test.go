@@ -0,0 +1,73 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgelaboratories/date"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Calendar_Holidays(t *testing.T) {
+	t.Parallel()
+
+	var (
+		from = date.New(2021, time.December, 1)
+		to   = date.New(2022, time.January, 31)
+	)
+
+	weekend, err := NewWithWeekend([]time.Weekday{time.Friday, time.Saturday}, date.New(2021, time.December, 27))
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		name     string
+		calendar *Calendar
+		expected []date.Date
+	}{
+		{
+			"business days",
+			New(BusinessDays),
+			[]date.Date{},
+		},
+		{
+			"calendar days",
+			New(CalendarDays),
+			[]date.Date{},
+		},
+		{
+			"holidays",
+			NewWithHolidays(date.New(2021, time.December, 24), date.New(2021, time.December, 25)),
+			[]date.Date{date.New(2021, time.December, 24)},
+		},
+		{
+			"custom weekend",
+			weekend,
+			[]date.Date{date.New(2021, time.December, 27)},
+		},
+		{
+			"market",
+			New(NYSE),
+			[]date.Date{
+				date.New(2021, time.December, 24),
+				date.New(2022, time.January, 17),
+			},
+		},
+		{
+			"joint",
+			newTestJoint(t, AllActive, New(NYSE), weekend),
+			[]date.Date{
+				date.New(2021, time.December, 27),
+				date.New(2022, time.January, 17),
+			},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, tc.calendar.Holidays(from, to))
+		})
+	}
+}
@@ -0,0 +1,435 @@
+package calendar
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/edgelaboratories/date"
+)
+
+// ParseICS returns the sorted dates between from and to (both included)
+// covered by the events of the input iCalendar data, as defined by
+// RFC 5545, so they can be used as holidays, e.g. with NewWithHolidays.
+//
+// All-day events and events with a time are supported, the latter
+// covering every date they overlap, regardless of time zones.
+// Recurring events are expanded according to their RRULE, RDATE and
+// EXDATE properties. Other components than VEVENT are ignored.
+// An error is returned for malformed input, including unsupported
+// recurrence rules.
+func ParseICS(r io.Reader, from, to date.Date) ([]date.Date, error) {
+	lines, err := unfoldICS(r)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := parseICSEvents(lines)
+	if err != nil {
+		return nil, err
+	}
+
+	covered := map[date.Date]struct{}{}
+
+	for _, event := range events {
+		for _, start := range event.starts(to) {
+			for i := 0; i < event.span; i++ {
+				current := start.Add(i)
+				if !current.Before(from) && !current.After(to) {
+					covered[current] = struct{}{}
+				}
+			}
+		}
+	}
+
+	dates := make([]date.Date, 0, len(covered))
+	for d := range covered {
+		dates = append(dates, d)
+	}
+
+	sort.Slice(dates, func(i, j int) bool {
+		return dates[i].Before(dates[j])
+	})
+
+	return dates, nil
+}
+
+// icsLine is an unfolded content line.
+type icsLine struct {
+	// number is the number of the first physical line it spans.
+	number int
+	text   string
+}
+
+// unfoldICS reads the content lines of the input data, joining the
+// lines folded over several physical lines.
+func unfoldICS(r io.Reader) ([]icsLine, error) {
+	lines := []icsLine{}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1024*1024)
+
+	for number := 1; scanner.Scan(); number++ {
+		text := scanner.Text()
+
+		if strings.HasPrefix(text, " ") || strings.HasPrefix(text, "\t") {
+			if len(lines) == 0 {
+				return nil, fmt.Errorf("calendar: ics line %d: unexpected continuation line", number)
+			}
+
+			lines[len(lines)-1].text += text[1:]
+
+			continue
+		}
+
+		if strings.TrimSpace(text) != "" {
+			lines = append(lines, icsLine{number, text})
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("calendar: reading ics data: %w", err)
+	}
+
+	return lines, nil
+}
+
+// icsProperty is a parsed content line.
+type icsProperty struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// parseICSProperty parses a content line of the form
+// NAME;PARAM=VALUE;...:VALUE, with optionally quoted parameter values.
+func parseICSProperty(text string) (icsProperty, error) {
+	parts := splitICS(text, ':', 2)
+	if len(parts) != 2 {
+		return icsProperty{}, errors.New("missing property value")
+	}
+
+	head := splitICS(parts[0], ';', -1)
+	if head[0] == "" {
+		return icsProperty{}, errors.New("missing property name")
+	}
+
+	property := icsProperty{
+		name:   strings.ToUpper(head[0]),
+		params: map[string]string{},
+		value:  parts[1],
+	}
+
+	for _, param := range head[1:] {
+		key, value, ok := strings.Cut(param, "=")
+		if !ok {
+			return icsProperty{}, fmt.Errorf("invalid parameter %q", param)
+		}
+
+		property.params[strings.ToUpper(key)] = strings.Trim(value, `"`)
+	}
+
+	return property, nil
+}
+
+// splitICS splits the input text around the separator, ignoring the
+// separators within double quotes, into at most n parts if n is positive.
+func splitICS(text string, separator rune, n int) []string {
+	parts := []string{}
+	quoted, start := false, 0
+
+	for i, r := range text {
+		if n > 0 && len(parts) == n-1 {
+			break
+		}
+
+		switch {
+		case r == '"':
+			quoted = !quoted
+		case r == separator && !quoted:
+			parts = append(parts, text[start:i])
+			start = i + 1
+		}
+	}
+
+	return append(parts, text[start:])
+}
+
+// icsTime is a DATE or DATE-TIME value, reduced to its date.
+type icsTime struct {
+	date   date.Date
+	allDay bool
+	// midnight is true for DATE-TIME values at 00:00:00.
+	midnight bool
+}
+
+// parseICSTime parses a DATE value such as 20211225, or a DATE-TIME
+// value such as 20211225T093000 or 20211225T093000Z.
+func parseICSTime(value string) (icsTime, error) {
+	if len(value) == len("20060102") {
+		t, err := time.Parse("20060102", value)
+		if err != nil {
+			return icsTime{}, fmt.Errorf("invalid date %q", value)
+		}
+
+		return icsTime{date.NewAt(t), true, true}, nil
+	}
+
+	t, err := time.Parse("20060102T150405", strings.TrimSuffix(value, "Z"))
+	if err != nil {
+		return icsTime{}, fmt.Errorf("invalid date-time %q", value)
+	}
+
+	return icsTime{date.NewAt(t), false, t.Hour() == 0 && t.Minute() == 0 && t.Second() == 0}, nil
+}
+
+// parseICSTimes parses a comma-separated list of DATE or DATE-TIME values.
+func parseICSTimes(property icsProperty) ([]date.Date, error) {
+	if value, ok := property.params["VALUE"]; ok && !strings.EqualFold(value, "DATE") && !strings.EqualFold(value, "DATE-TIME") {
+		return nil, fmt.Errorf("unsupported %s value type %q", property.name, value)
+	}
+
+	dates := []date.Date{}
+
+	for _, value := range strings.Split(property.value, ",") {
+		t, err := parseICSTime(value)
+		if err != nil {
+			return nil, err
+		}
+
+		dates = append(dates, t.date)
+	}
+
+	return dates, nil
+}
+
+// icsDuration matches the DURATION values, such as P1D, P2W or PT1H30M.
+var icsDuration = regexp.MustCompile(`^\+?P(?:(\d+)W|(?:(\d+)D)?(?:T(?:\d+H)?(?:\d+M)?(?:\d+S)?)?)$`)
+
+// parseICSDuration returns the number of whole days of a DURATION value.
+func parseICSDuration(value string) (int, error) {
+	matches := icsDuration.FindStringSubmatch(value)
+	if matches == nil || value == "P" || strings.HasSuffix(value, "T") {
+		return 0, fmt.Errorf("invalid duration %q", value)
+	}
+
+	if matches[1] != "" {
+		weeks, err := strconv.Atoi(matches[1])
+		return daysInWeek * weeks, err
+	}
+
+	if matches[2] != "" {
+		return strconv.Atoi(matches[2])
+	}
+
+	return 0, nil
+}
+
+// icsEvent is a VEVENT component.
+type icsEvent struct {
+	start    *icsTime
+	end      *icsTime
+	duration *int
+	rule     *recurrenceRule
+	rdates   []date.Date
+	exdates  map[date.Date]bool
+	// span is the number of dates covered by each occurrence.
+	span int
+}
+
+// parseICSEvents returns the events of the input content lines.
+func parseICSEvents(lines []icsLine) ([]*icsEvent, error) {
+	var (
+		events     []*icsEvent
+		event      *icsEvent
+		components []string
+	)
+
+	for _, line := range lines {
+		property, err := parseICSProperty(line.text)
+		if err != nil {
+			return nil, fmt.Errorf("calendar: ics line %d: %w", line.number, err)
+		}
+
+		switch property.name {
+		case "BEGIN":
+			component := strings.ToUpper(property.value)
+			if component == "VEVENT" {
+				if event != nil {
+					return nil, fmt.Errorf("calendar: ics line %d: nested VEVENT", line.number)
+				}
+
+				event = &icsEvent{exdates: map[date.Date]bool{}}
+			}
+
+			components = append(components, component)
+
+		case "END":
+			component := strings.ToUpper(property.value)
+			if len(components) == 0 || components[len(components)-1] != component {
+				return nil, fmt.Errorf("calendar: ics line %d: unexpected END:%s", line.number, property.value)
+			}
+
+			components = components[:len(components)-1]
+
+			if component == "VEVENT" {
+				if err := event.validate(); err != nil {
+					return nil, fmt.Errorf("calendar: ics line %d: %w", line.number, err)
+				}
+
+				events = append(events, event)
+				event = nil
+			}
+
+		default:
+			// Properties of components nested in events, such as alarms, are ignored.
+			if event != nil && components[len(components)-1] == "VEVENT" {
+				if err := event.set(property); err != nil {
+					return nil, fmt.Errorf("calendar: ics line %d: %w", line.number, err)
+				}
+			}
+		}
+	}
+
+	if len(components) != 0 {
+		return nil, fmt.Errorf("calendar: ics: unterminated %s component", components[len(components)-1])
+	}
+
+	return events, nil
+}
+
+// set sets the input property of the event.
+func (e *icsEvent) set(property icsProperty) error {
+	switch property.name {
+	case "DTSTART", "DTEND":
+		t, err := parseICSTime(property.value)
+		if err != nil {
+			return err
+		}
+
+		target := &e.start
+		if property.name == "DTEND" {
+			target = &e.end
+		}
+
+		if *target != nil {
+			return fmt.Errorf("duplicate %s", property.name)
+		}
+
+		*target = &t
+
+	case "DURATION":
+		days, err := parseICSDuration(property.value)
+		if err != nil {
+			return err
+		}
+
+		e.duration = &days
+
+	case "RRULE":
+		if e.rule != nil {
+			return errors.New("multiple RRULE properties are not supported")
+		}
+
+		rule, err := parseRecurrenceRule(property.value)
+		if err != nil {
+			return err
+		}
+
+		e.rule = &rule
+
+	case "RDATE":
+		dates, err := parseICSTimes(property)
+		if err != nil {
+			return err
+		}
+
+		e.rdates = append(e.rdates, dates...)
+
+	case "EXDATE":
+		dates, err := parseICSTimes(property)
+		if err != nil {
+			return err
+		}
+
+		for _, d := range dates {
+			e.exdates[d] = true
+		}
+	}
+
+	return nil
+}
+
+// validate checks the event is complete and computes its span.
+func (e *icsEvent) validate() error {
+	if e.start == nil {
+		return errors.New("event without DTSTART")
+	}
+
+	if e.end != nil && e.duration != nil {
+		return errors.New("event with both DTEND and DURATION")
+	}
+
+	e.span = 1
+
+	switch {
+	case e.end != nil:
+		if e.end.date.Before(e.start.date) {
+			return errors.New("event ending before its start")
+		}
+
+		// The end is excluded for all-day events, and for events
+		// with a time ending at midnight.
+		last := e.end.date
+		if e.start.allDay || e.end.midnight {
+			last = last.Add(-1)
+		}
+
+		if last.After(e.start.date) {
+			e.span = last.Sub(e.start.date) + 1
+		}
+
+	case e.duration != nil:
+		// Durations are counted in whole days, so events with a time
+		// not starting at midnight overlap one more date.
+		days := *e.duration
+		if !e.start.allDay && !e.start.midnight {
+			days++
+		}
+
+		if days > 1 {
+			e.span = days
+		}
+	}
+
+	return nil
+}
+
+// starts returns the start dates of the occurrences of the event,
+// up to the input date.
+func (e *icsEvent) starts(to date.Date) []date.Date {
+	candidates := []date.Date{e.start.date}
+	if e.rule != nil {
+		candidates = candidates[:0]
+		for d := range e.rule.occurrences(e.start.date, to) {
+			candidates = append(candidates, d)
+		}
+	}
+
+	candidates = append(candidates, e.rdates...)
+
+	starts := make([]date.Date, 0, len(candidates))
+	for _, d := range candidates {
+		if !e.exdates[d] {
+			starts = append(starts, d)
+		}
+	}
+
+	return starts
+}
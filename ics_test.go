@@ -0,0 +1,184 @@
+package calendar
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/edgelaboratories/date"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testICS = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"PRODID:-//Operations//Holidays//EN\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:new-year\r\n" +
+	"DTSTART;VALUE=DATE:20200101\r\n" +
+	"DTEND;VALUE=DATE:20200102\r\n" +
+	"RRULE:FREQ=YEARLY\r\n" +
+	"SUMMARY:New Year's\r\n" +
+	"  Day\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:thanksgiving\r\n" +
+	"DTSTART;VALUE=DATE:20201126\r\n" +
+	"RRULE:FREQ=YEARLY;BYMONTH=11;BYDAY=4TH\r\n" +
+	"EXDATE;VALUE=DATE:20211125\r\n" +
+	"BEGIN:VALARM\r\n" +
+	"ACTION:DISPLAY\r\n" +
+	"TRIGGER:-P1D\r\n" +
+	"END:VALARM\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:christmas\r\n" +
+	"DTSTART;VALUE=DATE:20211224\r\n" +
+	"DTEND;VALUE=DATE:20211227\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:closure\r\n" +
+	"DTSTART;TZID=\"America/New_York\":20210705T093000\r\n" +
+	"DTEND;TZID=\"America/New_York\":20210705T160000\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+func Test_ParseICS(t *testing.T) {
+	t.Parallel()
+
+	holidays, err := ParseICS(strings.NewReader(testICS), date.New(2020, time.June, 1), date.New(2022, time.January, 31))
+	require.NoError(t, err)
+
+	assert.Equal(t, []date.Date{
+		date.New(2020, time.November, 26),
+		date.New(2021, time.January, 1),
+		date.New(2021, time.July, 5),
+		date.New(2021, time.December, 24),
+		date.New(2021, time.December, 25),
+		date.New(2021, time.December, 26),
+		date.New(2022, time.January, 1),
+	}, holidays)
+
+	calendar := NewWithHolidays(holidays...)
+	assert.False(t, calendar.IsActive(date.New(2021, time.July, 5)))
+	assert.True(t, calendar.IsActive(date.New(2021, time.November, 25)))
+}
+
+func Test_ParseICS_Spans(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		event    string
+		expected []date.Date
+	}{
+		{
+			"all-day without end",
+			"DTSTART;VALUE=DATE:20210301\n",
+			[]date.Date{date.New(2021, time.March, 1)},
+		},
+		{
+			"all-day duration",
+			"DTSTART;VALUE=DATE:20210301\nDURATION:P2D\n",
+			[]date.Date{date.New(2021, time.March, 1), date.New(2021, time.March, 2)},
+		},
+		{
+			"overnight event",
+			"DTSTART:20210301T220000Z\nDTEND:20210302T020000Z\n",
+			[]date.Date{date.New(2021, time.March, 1), date.New(2021, time.March, 2)},
+		},
+		{
+			"event ending at midnight",
+			"DTSTART:20210301T000000\nDTEND:20210302T000000\n",
+			[]date.Date{date.New(2021, time.March, 1)},
+		},
+		{
+			"event with a time and a duration",
+			"DTSTART:20210301T120000\nDURATION:P1D\n",
+			[]date.Date{date.New(2021, time.March, 1), date.New(2021, time.March, 2)},
+		},
+		{
+			"additional dates",
+			"DTSTART;VALUE=DATE:20210301\nRDATE;VALUE=DATE:20210305,20210310\n",
+			[]date.Date{date.New(2021, time.March, 1), date.New(2021, time.March, 5), date.New(2021, time.March, 10)},
+		},
+		{
+			"excluded date-time",
+			"DTSTART:20210301T090000\nRRULE:FREQ=DAILY;COUNT=3\nEXDATE:20210302T090000\n",
+			[]date.Date{date.New(2021, time.March, 1), date.New(2021, time.March, 3)},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			data := "BEGIN:VCALENDAR\nBEGIN:VEVENT\n" + tc.event + "END:VEVENT\nEND:VCALENDAR\n"
+
+			holidays, err := ParseICS(strings.NewReader(data), date.New(2021, time.January, 1), date.New(2021, time.December, 31))
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, holidays)
+		})
+	}
+}
+
+func Test_ParseICS_Errors(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name string
+		data string
+	}{
+		{"leading continuation", " BEGIN:VCALENDAR\n"},
+		{"missing value", "BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART\nEND:VEVENT\nEND:VCALENDAR\n"},
+		{"missing name", "BEGIN:VCALENDAR\n:VALUE\nEND:VCALENDAR\n"},
+		{"invalid parameter", "BEGIN:VEVENT\nDTSTART;DATE:20210101\nEND:VEVENT\n"},
+		{"unterminated component", "BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART:20210101\n"},
+		{"unexpected end", "BEGIN:VCALENDAR\nEND:VEVENT\n"},
+		{"nested event", "BEGIN:VEVENT\nBEGIN:VEVENT\n"},
+		{"missing start", "BEGIN:VEVENT\nSUMMARY:Holiday\nEND:VEVENT\n"},
+		{"invalid date", "BEGIN:VEVENT\nDTSTART:20211301\nEND:VEVENT\n"},
+		{"invalid date-time", "BEGIN:VEVENT\nDTSTART:20210101T25\nEND:VEVENT\n"},
+		{"duplicate start", "BEGIN:VEVENT\nDTSTART:20210101\nDTSTART:20210102\nEND:VEVENT\n"},
+		{"end before start", "BEGIN:VEVENT\nDTSTART:20210102\nDTEND:20210101\nEND:VEVENT\n"},
+		{"end and duration", "BEGIN:VEVENT\nDTSTART:20210101\nDTEND:20210102\nDURATION:P1D\nEND:VEVENT\n"},
+		{"invalid duration", "BEGIN:VEVENT\nDTSTART:20210101\nDURATION:1D\nEND:VEVENT\n"},
+		{"invalid rule", "BEGIN:VEVENT\nDTSTART:20210101\nRRULE:FREQ=HOURLY\nEND:VEVENT\n"},
+		{"several rules", "BEGIN:VEVENT\nDTSTART:20210101\nRRULE:FREQ=DAILY\nRRULE:FREQ=WEEKLY\nEND:VEVENT\n"},
+		{"period dates", "BEGIN:VEVENT\nDTSTART:20210101\nRDATE;VALUE=PERIOD:20210102T000000Z/P1D\nEND:VEVENT\n"},
+		{"invalid excluded date", "BEGIN:VEVENT\nDTSTART:20210101\nEXDATE:2021-01-02\nEND:VEVENT\n"},
+	} {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseICS(strings.NewReader(tc.data), date.New(2021, time.January, 1), date.New(2021, time.December, 31))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func Test_parseICSDuration(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		value    string
+		expected int
+	}{
+		{"P1D", 1},
+		{"+P3D", 3},
+		{"P2W", 14},
+		{"PT1H30M", 0},
+		{"P1DT12H", 1},
+	} {
+		days, err := parseICSDuration(tc.value)
+		require.NoError(t, err, tc.value)
+		assert.Equal(t, tc.expected, days, tc.value)
+	}
+
+	for _, value := range []string{"", "P", "PT", "-P1D", "P1Y", "1D"} {
+		_, err := parseICSDuration(value)
+		assert.Error(t, err, value)
+	}
+}
@@ -0,0 +1,132 @@
+package calendar
+
+import (
+	"time"
+
+	"github.com/edgelaboratories/date"
+)
+
+// cdsRollDay is the day of the month of the CDS roll dates.
+const cdsRollDay = 20
+
+// rollSchedule defines recurring dates, such as IMM dates.
+type rollSchedule struct {
+	// quarterly restricts the dates to March, June, September and December.
+	quarterly bool
+	// nominal returns the nominal date of the input month.
+	nominal func(year int, month time.Month) date.Date
+	// adjustment rolls the nominal dates which are not active.
+	adjustment Adjustment
+}
+
+var (
+	// immDates are the third Wednesdays of the quarterly months,
+	// rolled to the following active date.
+	immDates = rollSchedule{true, thirdWeekday(time.Wednesday), Following}
+	// thirdFridays are the third Fridays of every month, such as the
+	// expiries of equity index options, rolled to the preceding active date.
+	thirdFridays = rollSchedule{false, thirdWeekday(time.Friday), Preceding}
+	// quarterlyExpiries are the third Fridays of the quarterly months,
+	// rolled to the preceding active date.
+	quarterlyExpiries = rollSchedule{true, thirdWeekday(time.Friday), Preceding}
+	// cdsRollDates are the 20th of the quarterly months, rolled to the
+	// following active date.
+	cdsRollDates = rollSchedule{true, func(year int, month time.Month) date.Date {
+		return date.New(year, month, cdsRollDay)
+	}, Following}
+)
+
+// thirdWeekday returns the function returning the third input weekday
+// of a month.
+func thirdWeekday(weekday time.Weekday) func(int, time.Month) date.Date {
+	return func(year int, month time.Month) date.Date {
+		return NthWeekday{3, weekday, month}.Holidays(year)[0]
+	}
+}
+
+// NextIMMDate returns the first IMM date after the input date, IMM dates
+// being the third Wednesdays of March, June, September and December,
+// rolled to the following active date.
+func (c *Calendar) NextIMMDate(d date.Date) date.Date {
+	return c.nextRoll(d, immDates)
+}
+
+// PreviousIMMDate returns the latest IMM date before the input date.
+func (c *Calendar) PreviousIMMDate(d date.Date) date.Date {
+	return c.previousRoll(d, immDates)
+}
+
+// NextThirdFriday returns the first third Friday of a month after the input
+// date, rolled to the preceding active date, such as equity index option
+// expiries.
+func (c *Calendar) NextThirdFriday(d date.Date) date.Date {
+	return c.nextRoll(d, thirdFridays)
+}
+
+// PreviousThirdFriday returns the latest third Friday of a month before
+// the input date, rolled to the preceding active date.
+func (c *Calendar) PreviousThirdFriday(d date.Date) date.Date {
+	return c.previousRoll(d, thirdFridays)
+}
+
+// NextQuarterlyExpiry returns the first quarterly expiry after the input
+// date, quarterly expiries being the third Fridays of March, June, September
+// and December, rolled to the preceding active date.
+func (c *Calendar) NextQuarterlyExpiry(d date.Date) date.Date {
+	return c.nextRoll(d, quarterlyExpiries)
+}
+
+// PreviousQuarterlyExpiry returns the latest quarterly expiry before
+// the input date.
+func (c *Calendar) PreviousQuarterlyExpiry(d date.Date) date.Date {
+	return c.previousRoll(d, quarterlyExpiries)
+}
+
+// NextCDSRollDate returns the first CDS roll date after the input date,
+// CDS roll dates being the 20th of March, June, September and December,
+// rolled to the following active date.
+func (c *Calendar) NextCDSRollDate(d date.Date) date.Date {
+	return c.nextRoll(d, cdsRollDates)
+}
+
+// PreviousCDSRollDate returns the latest CDS roll date before the input date.
+func (c *Calendar) PreviousCDSRollDate(d date.Date) date.Date {
+	return c.previousRoll(d, cdsRollDates)
+}
+
+// nextRoll returns the first adjusted date of the schedule after the
+// input date.
+func (c *Calendar) nextRoll(d date.Date, schedule rollSchedule) date.Date {
+	// Start from the previous month, as adjusted dates may move
+	// across months.
+	for month := addMonths(firstDayOfMonth(d), -1); ; month = addMonths(month, 1) {
+		if roll, ok := c.roll(month, schedule); ok && roll.After(d) {
+			return roll
+		}
+	}
+}
+
+// previousRoll returns the latest adjusted date of the schedule before
+// the input date.
+func (c *Calendar) previousRoll(d date.Date, schedule rollSchedule) date.Date {
+	for month := addMonths(firstDayOfMonth(d), 1); ; month = addMonths(month, -1) {
+		if roll, ok := c.roll(month, schedule); ok && roll.Before(d) {
+			return roll
+		}
+	}
+}
+
+// roll returns the adjusted date of the schedule in the month starting
+// at the input date, if any.
+func (c *Calendar) roll(month date.Date, schedule rollSchedule) (date.Date, bool) {
+	if schedule.quarterly && month.Month()%3 != 0 {
+		return date.Date{}, false
+	}
+
+	return c.Adjust(schedule.nominal(month.Year(), month.Month()), schedule.adjustment), true
+}
+
+// firstDayOfMonth returns the first day of the month of the input date.
+func firstDayOfMonth(d date.Date) date.Date {
+	return date.New(d.Year(), d.Month(), 1)
+}
@@ -0,0 +1,166 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgelaboratories/date"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Calendar_IMMDates(t *testing.T) {
+	t.Parallel()
+
+	var (
+		business = New(BusinessDays)
+		nyse     = New(NYSE)
+	)
+
+	for _, tc := range []struct {
+		name     string
+		calendar *Calendar
+		date     date.Date
+		next     date.Date
+		previous date.Date
+	}{
+		{
+			"within quarter",
+			business,
+			date.New(2024, time.February, 1),
+			date.New(2024, time.March, 20),
+			date.New(2023, time.December, 20),
+		},
+		{
+			"on IMM date",
+			business,
+			date.New(2024, time.March, 20),
+			date.New(2024, time.June, 19),
+			date.New(2023, time.December, 20),
+		},
+		{
+			"end of year",
+			business,
+			date.New(2024, time.December, 31),
+			date.New(2025, time.March, 19),
+			date.New(2024, time.December, 18),
+		},
+		{
+			"on holiday",
+			nyse,
+			date.New(2024, time.June, 19),
+			date.New(2024, time.June, 20),
+			date.New(2024, time.March, 20),
+		},
+		{
+			"after holiday",
+			nyse,
+			date.New(2024, time.June, 21),
+			date.New(2024, time.September, 18),
+			date.New(2024, time.June, 20),
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.next, tc.calendar.NextIMMDate(tc.date))
+			assert.Equal(t, tc.previous, tc.calendar.PreviousIMMDate(tc.date))
+		})
+	}
+}
+
+func Test_Calendar_ThirdFridays(t *testing.T) {
+	t.Parallel()
+
+	nyse := New(NYSE)
+
+	for _, tc := range []struct {
+		name              string
+		date              date.Date
+		next              date.Date
+		previous          date.Date
+		nextQuarterly     date.Date
+		previousQuarterly date.Date
+	}{
+		{
+			"within month",
+			date.New(2024, time.April, 1),
+			date.New(2024, time.April, 19),
+			date.New(2024, time.March, 15),
+			date.New(2024, time.June, 21),
+			date.New(2024, time.March, 15),
+		},
+		{
+			"on expiry",
+			date.New(2024, time.March, 15),
+			date.New(2024, time.April, 19),
+			date.New(2024, time.February, 16),
+			date.New(2024, time.June, 21),
+			date.New(2023, time.December, 15),
+		},
+		{
+			"good friday",
+			date.New(2025, time.April, 10),
+			date.New(2025, time.April, 17),
+			date.New(2025, time.March, 21),
+			date.New(2025, time.June, 20),
+			date.New(2025, time.March, 21),
+		},
+		{
+			"between holiday and nominal date",
+			date.New(2025, time.April, 17),
+			date.New(2025, time.May, 16),
+			date.New(2025, time.March, 21),
+			date.New(2025, time.June, 20),
+			date.New(2025, time.March, 21),
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.next, nyse.NextThirdFriday(tc.date))
+			assert.Equal(t, tc.previous, nyse.PreviousThirdFriday(tc.date))
+			assert.Equal(t, tc.nextQuarterly, nyse.NextQuarterlyExpiry(tc.date))
+			assert.Equal(t, tc.previousQuarterly, nyse.PreviousQuarterlyExpiry(tc.date))
+		})
+	}
+}
+
+func Test_Calendar_CDSRollDates(t *testing.T) {
+	t.Parallel()
+
+	calendar := New(BusinessDays)
+
+	for _, tc := range []struct {
+		date     date.Date
+		next     date.Date
+		previous date.Date
+	}{
+		{
+			date.New(2021, time.March, 1),
+			date.New(2021, time.March, 22),
+			date.New(2020, time.December, 21),
+		},
+		{
+			date.New(2021, time.March, 22),
+			date.New(2021, time.June, 21),
+			date.New(2020, time.December, 21),
+		},
+		{
+			date.New(2021, time.March, 20),
+			date.New(2021, time.March, 22),
+			date.New(2020, time.December, 21),
+		},
+		{
+			date.New(2021, time.September, 21),
+			date.New(2021, time.December, 20),
+			date.New(2021, time.September, 20),
+		},
+	} {
+		assert.Equal(t, tc.next, calendar.NextCDSRollDate(tc.date))
+		assert.Equal(t, tc.previous, calendar.PreviousCDSRollDate(tc.date))
+	}
+}
@@ -0,0 +1,114 @@
+package calendar
+
+import (
+	"github.com/edgelaboratories/date"
+)
+
+// indexedCalendar is a calendar whose active days are the ones of a base
+// calendar, indexed over a window of dates so that counts and shifts
+// within the window are computed in constant time.
+// Outside of the window, the base calendar is used.
+type indexedCalendar struct {
+	base DayCounter
+	// start is the first date of the window.
+	start date.Date
+	// counts gives, per date of the window, the number of active days
+	// between start and the date (both included).
+	counts []int32
+	// active is the sorted list of the active days of the window.
+	active []date.Date
+}
+
+func newIndexedCalendar(base DayCounter, from, to date.Date) *indexedCalendar {
+	c := &indexedCalendar{
+		base:  base,
+		start: from,
+	}
+
+	if to.Before(from) {
+		return c
+	}
+
+	c.counts = make([]int32, to.Sub(from)+1)
+	for i := range c.counts {
+		if current := from.Add(i); base.IsActive(current) {
+			c.active = append(c.active, current)
+		}
+
+		c.counts[i] = int32(len(c.active))
+	}
+
+	return c
+}
+
+// Convention returns the convention of the base calendar.
+func (c indexedCalendar) Convention() Convention {
+	return c.base.Convention()
+}
+
+// IsActive returns true if the input date is active according
+// to the base calendar.
+func (c indexedCalendar) IsActive(date date.Date) bool {
+	i, ok := c.index(date)
+	if !ok {
+		return c.base.IsActive(date)
+	}
+
+	return c.countUntil(i) != c.countUntil(i-1)
+}
+
+// DaysInYear returns the standard year duration of the base calendar.
+func (c indexedCalendar) DaysInYear() int {
+	return c.base.DaysInYear()
+}
+
+// Add adds an input number of active days to the input origin date.
+// The days parameter is allowed to be negative.
+// This method is idempotent when a zero-days shift is requested.
+func (c indexedCalendar) Add(origin date.Date, days int) date.Date {
+	i, ok := c.index(origin)
+	if ok && c.counts[i] != 0 {
+		// The latest active day before origin has rank counts[i]-1.
+		if k := int(c.counts[i]) - 1 + days; k >= 0 && k < len(c.active) {
+			return c.active[k]
+		}
+	}
+
+	return c.base.Add(origin, days)
+}
+
+// DaysBetween computes the number of active dates between
+// from (excluded) and to (included).
+func (c indexedCalendar) DaysBetween(from, to date.Date) int {
+	i, fromOK := c.index(from)
+	j, toOK := c.index(to)
+
+	if !fromOK || !toOK {
+		return c.base.DaysBetween(from, to)
+	}
+
+	return c.countUntil(j) - c.countUntil(i)
+}
+
+// index returns the index of the input date in the window, and
+// whether it belongs to it.
+func (c indexedCalendar) index(date date.Date) (int, bool) {
+	i := date.Sub(c.start)
+
+	return i, i >= 0 && i < len(c.counts)
+}
+
+// countUntil returns the number of active days between the start
+// of the window and the date of index i (both included).
+func (c indexedCalendar) countUntil(i int) int {
+	if i < 0 {
+		return 0
+	}
+
+	return int(c.counts[i])
+}
+
+// unwrap returns the base calendar.
+func (c indexedCalendar) unwrap() DayCounter {
+	return c.base
+}
@@ -0,0 +1,99 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgelaboratories/date"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_indexedCalendar(t *testing.T) {
+	t.Parallel()
+
+	var (
+		base  = marketCalendars[NYSE]()
+		from  = date.New(2021, time.January, 1)
+		to    = date.New(2021, time.December, 31)
+		start = date.New(2020, time.November, 1)
+		end   = date.New(2022, time.February, 28)
+	)
+
+	for _, tc := range []struct {
+		name     string
+		calendar *indexedCalendar
+	}{
+		{"window", newIndexedCalendar(base, from, to)},
+		{"empty window", newIndexedCalendar(base, to, from)},
+		{"single day", newIndexedCalendar(base, from, from)},
+	} {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, NYSE, tc.calendar.Convention())
+			assert.Equal(t, base.DaysInYear(), tc.calendar.DaysInYear())
+
+			// Dates are checked within the window and around it, so that
+			// both the index and the fallback to the base calendar are used.
+			for current := start; !current.After(end); current = current.Add(1) {
+				assert.Equal(t, base.IsActive(current), tc.calendar.IsActive(current), current)
+
+				for _, days := range []int{-300, -20, -1, 0, 1, 20, 300} {
+					assert.Equal(t, base.Add(current, days), tc.calendar.Add(current, days), "%s %d", current, days)
+				}
+
+				for _, other := range []date.Date{start, from.Add(-1), from, from.Add(45), to, end} {
+					assert.Equal(t, base.DaysBetween(other, current), tc.calendar.DaysBetween(other, current), "%s %s", other, current)
+				}
+			}
+		})
+	}
+}
+
+func Test_NewIndexed(t *testing.T) {
+	t.Parallel()
+
+	holidays := []date.Date{date.New(2021, time.December, 24), date.New(2021, time.December, 31)}
+	calendar := NewIndexed(NewWithHolidays(holidays...), date.New(2021, time.January, 1), date.New(2021, time.December, 31))
+
+	assert.Equal(t, BusinessDays, calendar.Convention())
+	assert.Equal(t, date.New(2022, time.January, 3), calendar.Add(date.New(2021, time.December, 23), 5))
+	assert.Equal(t, holidays, calendar.Holidays(date.New(2021, time.January, 1), date.New(2021, time.December, 31)))
+
+	description, err := calendar.Describe()
+	assert.NoError(t, err)
+	assert.Equal(t, Description{Convention: BusinessDays, Holidays: holidays}, description)
+}
+
+func Benchmark_indexedCalendar(b *testing.B) {
+	var (
+		from     = date.New(2021, time.January, 1)
+		to       = date.New(2031, time.January, 1)
+		holidays = marketCalendars[NYSE]()
+	)
+
+	for _, bc := range []struct {
+		name string
+		c    DayCounter
+	}{
+		{"business", newBusinessCalendar()},
+		{"holidays", holidays},
+		{"indexed", newIndexedCalendar(holidays, date.New(marketFromYear, time.January, 1), date.New(marketToYear, time.December, 31))},
+	} {
+		bc := bc
+
+		b.Run(bc.name+"/Add", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = bc.c.Add(from, 2520)
+			}
+		})
+
+		b.Run(bc.name+"/DaysBetween", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = bc.c.DaysBetween(from, to)
+			}
+		})
+	}
+}
@@ -0,0 +1,228 @@
+package calendar
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgelaboratories/date"
+)
+
+// JointRule defines how the active days of joint calendars are combined.
+type JointRule string
+
+const (
+	// AllActive considers a date active if it is active
+	// in all the joint calendars.
+	AllActive JointRule = "AllActive"
+	// AnyActive considers a date active if it is active
+	// in at least one of the joint calendars.
+	AnyActive JointRule = "AnyActive"
+)
+
+// ErrNoActiveDays is returned when combining calendars without
+// active days in common.
+var ErrNoActiveDays = errors.New("calendar: no active days")
+
+// jointSearchDays is the number of days over which joint calendars are
+// checked to have active days, starting from jointSearchStart.
+const jointSearchDays = 366
+
+// jointSearchStart is the first date over which joint calendars are
+// checked to have active days.
+var jointSearchStart = date.New(2024, time.January, 1)
+
+// jointCalendar is a calendar whose active days are the combination
+// of the active days of several calendars.
+type jointCalendar struct {
+	rule      JointRule
+	calendars []DayCounter
+	// sessions returns the sorted list of the half days of the joint
+	// calendar, computed on first use.
+	sessions func() []date.Date
+}
+
+func newJointCalendar(rule JointRule, calendars []DayCounter) (*jointCalendar, error) {
+	c := &jointCalendar{
+		rule:      rule,
+		calendars: calendars,
+	}
+
+	if !c.hasActiveDays() {
+		return nil, ErrNoActiveDays
+	}
+
+	c.sessions = sync.OnceValue(c.computeHalfDays)
+
+	return c, nil
+}
+
+// Convention returns the conventions of the joint calendars,
+// separated by "&" for the AllActive rule and by "|" for the
+// AnyActive rule.
+func (c jointCalendar) Convention() Convention {
+	separator := "&"
+	if c.rule == AnyActive {
+		separator = "|"
+	}
+
+	conventions := make([]string, 0, len(c.calendars))
+	for _, calendar := range c.calendars {
+		conventions = append(conventions, string(calendar.Convention()))
+	}
+
+	return Convention(strings.Join(conventions, separator))
+}
+
+// IsActive returns true if the input date is active in all the
+// joint calendars for the AllActive rule, or in at least one of
+// them for the AnyActive rule.
+func (c jointCalendar) IsActive(date date.Date) bool {
+	all := c.rule != AnyActive
+
+	for _, calendar := range c.calendars {
+		if calendar.IsActive(date) != all {
+			return !all
+		}
+	}
+
+	return all
+}
+
+// DaysInYear returns the shortest standard year duration of the joint
+// calendars for the AllActive rule, and the longest one for the
+// AnyActive rule.
+func (c jointCalendar) DaysInYear() int {
+	days := c.calendars[0].DaysInYear()
+
+	for _, calendar := range c.calendars[1:] {
+		current := calendar.DaysInYear()
+		if (c.rule == AnyActive) == (current > days) {
+			days = current
+		}
+	}
+
+	return days
+}
+
+// Add adds an input number of active days to the input origin date.
+// The days parameter is allowed to be negative.
+// This method is idempotent when a zero-days shift is requested.
+func (c jointCalendar) Add(origin date.Date, days int) date.Date {
+	current := origin
+	for !c.IsActive(current) {
+		current = current.Add(-1)
+	}
+
+	shift := 1
+	if days < 0 {
+		shift, days = -1, -days
+	}
+
+	for days > 0 {
+		current = current.Add(shift)
+		if c.IsActive(current) {
+			days--
+		}
+	}
+
+	return current
+}
+
+// DaysBetween computes the number of active dates between
+// from (excluded) and to (included).
+func (c jointCalendar) DaysBetween(from, to date.Date) int {
+	if from.After(to) {
+		return -c.DaysBetween(to, from)
+	}
+
+	days := 0
+	for current := from.Add(1); !current.After(to); current = current.Add(1) {
+		if c.IsActive(current) {
+			days++
+		}
+	}
+
+	return days
+}
+
+// isWeekend returns true if the input date is a weekend day of at least
+// one of the joint calendars for the AllActive rule, or of all of them
+// for the AnyActive rule.
+func (c jointCalendar) isWeekend(date date.Date) bool {
+	all := c.rule == AnyActive
+
+	for _, calendar := range c.calendars {
+		if isWeekend(calendar, date) != all {
+			return !all
+		}
+	}
+
+	return all
+}
+
+// hasActiveDays returns true if the joint calendar has an active day
+// within a year. Otherwise, it is assumed to have no active days at all,
+// as searching for them, such as in Add, would never end.
+// The search stops at the first active day, so that it is quick for
+// regular calendars.
+func (c jointCalendar) hasActiveDays() bool {
+	for i := 0; i < jointSearchDays; i++ {
+		if c.IsActive(jointSearchStart.Add(i)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasActualBasis returns true if all the joint calendars use
+// the actual basis.
+func (c jointCalendar) hasActualBasis() bool {
+	for _, calendar := range c.calendars {
+		if !hasActualBasis(calendar) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// halfDays returns the sorted half days of the joint calendar.
+func (c jointCalendar) halfDays() []date.Date {
+	return c.sessions()
+}
+
+// computeHalfDays returns the sorted half days of the joint calendar.
+// A date is a half day if it is a half day in one of the calendars
+// for the AllActive rule, and in all the calendars where it is active
+// for the AnyActive rule.
+func (c jointCalendar) computeHalfDays() []date.Date {
+	candidates := []date.Date{}
+	for _, calendar := range c.calendars {
+		candidates = append(candidates, halfDays(calendar)...)
+	}
+
+	sessions := []date.Date{}
+
+	for _, candidate := range sortDates(candidates) {
+		if c.rule != AnyActive || c.isHalfDayInAll(candidate) {
+			sessions = append(sessions, candidate)
+		}
+	}
+
+	return sessions
+}
+
+// isHalfDayInAll returns true if the input date is a half day in all
+// the joint calendars where it is active.
+func (c jointCalendar) isHalfDayInAll(date date.Date) bool {
+	for _, calendar := range c.calendars {
+		if calendar.IsActive(date) && !containsDate(halfDays(calendar), date) {
+			return false
+		}
+	}
+
+	return true
+}
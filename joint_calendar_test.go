@@ -0,0 +1,288 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgelaboratories/date"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestJoint returns the joint calendar of the input calendars,
+// failing the test if it can't be built.
+func newTestJoint(t *testing.T, rule JointRule, calendar *Calendar, others ...*Calendar) *Calendar {
+	t.Helper()
+
+	joint, err := NewJoint(rule, calendar, others...)
+	require.NoError(t, err)
+
+	return joint
+}
+
+func Test_jointCalendar_Convention(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, Convention("NYSE&TARGET2"), newTestJoint(t, AllActive, New(NYSE), New(TARGET2)).Convention())
+	assert.Equal(t, Convention("NYSE|TARGET2"), newTestJoint(t, AnyActive, New(NYSE), New(TARGET2)).Convention())
+	assert.Equal(t, NYSE, newTestJoint(t, AllActive, New(NYSE)).Convention())
+}
+
+// parityCalendar is a custom calendar whose active days are the odd
+// or even days of the month, without weekend days.
+type parityCalendar struct {
+	odd bool
+}
+
+func (c parityCalendar) Convention() Convention {
+	return "Parity"
+}
+
+func (c parityCalendar) IsActive(d date.Date) bool {
+	return (d.Day()%2 == 1) == c.odd
+}
+
+func (c parityCalendar) DaysInYear() int {
+	return 182
+}
+
+func (c parityCalendar) DaysBetween(from, to date.Date) int {
+	if from.After(to) {
+		return -c.DaysBetween(to, from)
+	}
+
+	days := 0
+	for current := from.Add(1); !current.After(to); current = current.Add(1) {
+		if c.IsActive(current) {
+			days++
+		}
+	}
+
+	return days
+}
+
+func (c parityCalendar) Add(origin date.Date, days int) date.Date {
+	current := origin
+	for !c.IsActive(current) {
+		current = current.Add(-1)
+	}
+
+	shift := 1
+	if days < 0 {
+		shift, days = -1, -days
+	}
+
+	for days > 0 {
+		current = current.Add(shift)
+		if c.IsActive(current) {
+			days--
+		}
+	}
+
+	return current
+}
+
+func Test_NewJoint_NoActiveDays(t *testing.T) {
+	t.Parallel()
+
+	weekend, err := NewWithWeekend([]time.Weekday{
+		time.Monday,
+		time.Tuesday,
+		time.Wednesday,
+		time.Thursday,
+		time.Friday,
+	})
+	require.NoError(t, err)
+
+	var (
+		odd  = NewFrom(parityCalendar{odd: true})
+		even = NewFrom(parityCalendar{odd: false})
+	)
+
+	_, err = NewJoint(AllActive, weekend, New(BusinessDays))
+	assert.ErrorIs(t, err, ErrNoActiveDays)
+
+	_, err = NewJoint(AllActive, New(NYSE), New(LSE), weekend)
+	assert.ErrorIs(t, err, ErrNoActiveDays)
+
+	// Custom calendars don't define their weekend days.
+	_, err = NewJoint(AllActive, odd, even)
+	assert.ErrorIs(t, err, ErrNoActiveDays)
+
+	// Weekends only active in one calendar are active for the AnyActive rule.
+	either := newTestJoint(t, AnyActive, weekend, New(BusinessDays))
+	assert.Equal(t, date.New(2024, time.January, 2), either.Add(date.New(2024, time.January, 1), 1))
+
+	// Calendar days have no weekend days.
+	both := newTestJoint(t, AllActive, weekend, New(CalendarDays))
+	assert.Equal(t, date.New(2024, time.January, 6), both.Add(date.New(2024, time.January, 1), 1))
+
+	parity := newTestJoint(t, AnyActive, odd, even)
+	assert.Equal(t, date.New(2024, time.January, 2), parity.Add(date.New(2024, time.January, 1), 1))
+}
+
+func Test_jointCalendar_IsActive(t *testing.T) {
+	t.Parallel()
+
+	var (
+		both   = newTestJoint(t, AllActive, New(NYSE), New(TARGET2))
+		either = newTestJoint(t, AnyActive, New(NYSE), New(TARGET2))
+	)
+
+	for _, tc := range []struct {
+		date   date.Date
+		both   bool
+		either bool
+	}{
+		{
+			// Open on both calendars.
+			date.New(2024, time.April, 2),
+			true,
+			true,
+		},
+		{
+			// Good Friday, closed on both calendars.
+			date.New(2024, time.March, 29),
+			false,
+			false,
+		},
+		{
+			// Easter Monday, only closed on TARGET2.
+			date.New(2024, time.April, 1),
+			false,
+			true,
+		},
+		{
+			// Independence Day, only closed on NYSE.
+			date.New(2024, time.July, 4),
+			false,
+			true,
+		},
+		{
+			// Saturday.
+			date.New(2024, time.July, 6),
+			false,
+			false,
+		},
+	} {
+		assert.Equal(t, tc.both, both.IsActive(tc.date))
+		assert.Equal(t, tc.either, either.IsActive(tc.date))
+	}
+}
+
+func Test_jointCalendar_DaysInYear(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 252, newTestJoint(t, AllActive, New(CalendarDays), New(BusinessDays)).DaysInYear())
+	assert.Equal(t, 365, newTestJoint(t, AnyActive, New(CalendarDays), New(BusinessDays)).DaysInYear())
+}
+
+func Test_jointCalendar_Add_DaysBetween(t *testing.T) {
+	t.Parallel()
+
+	var (
+		both   = newTestJoint(t, AllActive, New(NYSE), New(TARGET2))
+		either = newTestJoint(t, AnyActive, New(NYSE), New(TARGET2))
+	)
+
+	for _, tc := range []struct {
+		name     string
+		calendar *Calendar
+		origin   date.Date
+		days     int
+		expected date.Date
+	}{
+		{
+			"both/zero shift on holiday",
+			both,
+			date.New(2024, time.April, 1),
+			0,
+			date.New(2024, time.March, 28),
+		},
+		{
+			"both/across easter",
+			both,
+			date.New(2024, time.March, 28),
+			1,
+			date.New(2024, time.April, 2),
+		},
+		{
+			"both/backwards across easter",
+			both,
+			date.New(2024, time.April, 2),
+			-1,
+			date.New(2024, time.March, 28),
+		},
+		{
+			"either/zero shift on holiday",
+			either,
+			date.New(2024, time.April, 1),
+			0,
+			date.New(2024, time.April, 1),
+		},
+		{
+			"either/across easter",
+			either,
+			date.New(2024, time.March, 28),
+			1,
+			date.New(2024, time.April, 1),
+		},
+		{
+			"either/across independence day",
+			either,
+			date.New(2024, time.July, 3),
+			2,
+			date.New(2024, time.July, 5),
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := tc.calendar.Add(tc.origin, tc.days)
+
+			assert.Equal(t, tc.expected, actual)
+
+			if tc.days != 0 {
+				assert.Equal(t, tc.days, tc.calendar.DaysBetween(tc.origin, actual))
+				assert.Equal(t, -tc.days, tc.calendar.DaysBetween(actual, tc.origin))
+			}
+		})
+	}
+}
+
+func Test_jointCalendar_ConsistencyChecks(t *testing.T) {
+	t.Parallel()
+
+	calendar := newTestJoint(t, AllActive, New(LSE), New(TSE))
+
+	origin := date.New(2018, time.December, 24)
+	for j := 0; j < 7; j++ {
+		current := origin.Add(j)
+
+		for i := 1; i <= 256; i++ {
+			to := calendar.Add(current, i)
+			from := calendar.Add(to, -i)
+
+			assert.True(t, calendar.IsActive(to))
+			assert.Equal(t, i, calendar.DaysBetween(current, to))
+			assert.Equal(t, i, calendar.DaysBetween(from, to))
+			assert.True(t, calendar.Add(from, i).Equal(to))
+		}
+	}
+}
+
+func Benchmark_NewJoint(b *testing.B) {
+	var (
+		nyse = New(NYSE)
+		lse  = New(LSE)
+	)
+
+	b.ReportAllocs()
+	b.RunParallel(func(p *testing.PB) {
+		for p.Next() {
+			_, _ = NewJoint(AllActive, nyse, lse)
+		}
+	})
+}
@@ -0,0 +1,358 @@
+package calendar
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/edgelaboratories/date"
+)
+
+const (
+	// marketFromYear is the first year for which the holidays of
+	// the market calendars are generated.
+	marketFromYear = 1980
+	// marketToYear is the last year for which the holidays of
+	// the market calendars are generated.
+	marketToYear = 2099
+)
+
+// newMarketCalendar returns a holiday calendar built on top of the
+// business-days calendar, whose holidays are generated by the input
+// rules over the supported year range.
+func newMarketCalendar(convention Convention, rules ...HolidayRule) func() *holidayCalendar {
+	return sync.OnceValue(func() *holidayCalendar {
+		return newHolidayCalendar(
+			convention,
+			newBusinessCalendar(),
+			GenerateHolidays(marketFromYear, marketToYear, rules...),
+		)
+	})
+}
+
+// marketCalendars holds the market calendars, lazily built on first use.
+// Outside of the supported year range, only weekends are inactive.
+var marketCalendars = map[Convention]func() *holidayCalendar{
+	NYSE:          newMarketCalendar(NYSE, nyseHolidays...),
+	LSE:           newMarketCalendar(LSE, lseHolidays...),
+	TARGET2:       newMarketCalendar(TARGET2, target2Holidays...),
+	TSE:           newMarketCalendar(TSE, tseHolidays{}),
+	EuronextParis: newMarketCalendar(EuronextParis, euronextParisHolidays...),
+}
+
+// indexedMarketCalendars holds the market calendars indexed over the
+// supported year range, along with their early closes as half days,
+// lazily built on first use.
+var indexedMarketCalendars = func() map[Convention]func() *halfDayCalendar {
+	calendars := make(map[Convention]func() *halfDayCalendar, len(marketCalendars))
+
+	for convention, market := range marketCalendars {
+		calendars[convention] = sync.OnceValue(func() *halfDayCalendar {
+			return &halfDayCalendar{
+				DayCounter: newIndexedCalendar(
+					market(),
+					date.New(marketFromYear, time.January, 1),
+					date.New(marketToYear, time.December, 31),
+				),
+				all: GenerateHolidays(marketFromYear, marketToYear, marketEarlyCloses[convention]...),
+			}
+		})
+	}
+
+	return calendars
+}()
+
+// marketEarlyCloses are the dates on which markets close early, when active.
+var marketEarlyCloses = map[Convention][]HolidayRule{
+	NYSE: {
+		// Independence Day eve.
+		FixedDate{time.July, 3},
+		// Day after Thanksgiving.
+		dayAfter{NthWeekday{4, time.Thursday, time.November}},
+		// Christmas Eve.
+		FixedDate{time.December, 24},
+	},
+	LSE: {
+		// Christmas Eve and New Year's Eve.
+		FixedDate{time.December, 24},
+		FixedDate{time.December, 31},
+	},
+	EuronextParis: {
+		// Christmas Eve and New Year's Eve.
+		FixedDate{time.December, 24},
+		FixedDate{time.December, 31},
+	},
+}
+
+// dayAfter is a holiday occurring the day after the ones of a rule.
+type dayAfter struct {
+	HolidayRule
+}
+
+// Holidays returns the days after the holidays of the rule.
+func (r dayAfter) Holidays(year int) []date.Date {
+	holidays := r.HolidayRule.Holidays(year)
+	for i := range holidays {
+		holidays[i] = holidays[i].Add(1)
+	}
+
+	return holidays
+}
+
+// nyseHolidays are the holidays of the New York Stock Exchange.
+var nyseHolidays = []HolidayRule{
+	// New Year's Day is not observed when on a Saturday.
+	Observed{FixedDate{time.January, 1}, SundayToMonday},
+	// Martin Luther King, Jr. Day.
+	Years{NthWeekday{3, time.Monday, time.January}, 1998, 0},
+	// Washington's Birthday.
+	NthWeekday{3, time.Monday, time.February},
+	// Good Friday.
+	EasterOffset{-2},
+	// Memorial Day.
+	LastWeekday{time.Monday, time.May},
+	// Juneteenth National Independence Day.
+	Years{Observed{FixedDate{time.June, 19}, NearestWeekday}, 2022, 0},
+	// Independence Day.
+	Observed{FixedDate{time.July, 4}, NearestWeekday},
+	// Labor Day.
+	NthWeekday{1, time.Monday, time.September},
+	// Thanksgiving Day.
+	NthWeekday{4, time.Thursday, time.November},
+	// Christmas Day.
+	Observed{FixedDate{time.December, 25}, NearestWeekday},
+	// Special closures.
+	SpecificDates{
+		// Presidential election day.
+		date.New(1980, time.November, 4),
+		// Hurricane Gloria.
+		date.New(1985, time.September, 27),
+		// Funeral of former President Nixon.
+		date.New(1994, time.April, 27),
+		// September 11 attacks.
+		date.New(2001, time.September, 11),
+		date.New(2001, time.September, 12),
+		date.New(2001, time.September, 13),
+		date.New(2001, time.September, 14),
+		// Funeral of former President Reagan.
+		date.New(2004, time.June, 11),
+		// Funeral of former President Ford.
+		date.New(2007, time.January, 2),
+		// Hurricane Sandy.
+		date.New(2012, time.October, 29),
+		date.New(2012, time.October, 30),
+		// Funeral of former President Bush.
+		date.New(2018, time.December, 5),
+		// Funeral of former President Carter.
+		date.New(2025, time.January, 9),
+	},
+}
+
+// lseHolidays are the holidays of the London Stock Exchange.
+var lseHolidays = []HolidayRule{
+	// New Year's Day.
+	Observed{FixedDate{time.January, 1}, WeekendToMonday},
+	// Good Friday.
+	EasterOffset{-2},
+	// Easter Monday.
+	EasterOffset{1},
+	// Early May bank holiday, exceptionally moved in 1995 and 2020.
+	ExceptYears{NthWeekday{1, time.Monday, time.May}, []int{1995, 2020}},
+	// Spring bank holiday, exceptionally moved for jubilees.
+	ExceptYears{LastWeekday{time.Monday, time.May}, []int{2002, 2012, 2022}},
+	// Summer bank holiday.
+	LastWeekday{time.Monday, time.August},
+	// Christmas Day and Boxing Day.
+	Observed{FixedDate{time.December, 25}, WeekendPlusTwoDays},
+	Observed{FixedDate{time.December, 26}, WeekendPlusTwoDays},
+	// Moved bank holidays and special closures.
+	SpecificDates{
+		// Royal wedding.
+		date.New(1981, time.July, 29),
+		// VE day anniversary.
+		date.New(1995, time.May, 8),
+		// Millennium.
+		date.New(1999, time.December, 31),
+		// Golden Jubilee.
+		date.New(2002, time.June, 3),
+		date.New(2002, time.June, 4),
+		// Royal wedding.
+		date.New(2011, time.April, 29),
+		// Diamond Jubilee.
+		date.New(2012, time.June, 4),
+		date.New(2012, time.June, 5),
+		// VE day anniversary.
+		date.New(2020, time.May, 8),
+		// Platinum Jubilee.
+		date.New(2022, time.June, 2),
+		date.New(2022, time.June, 3),
+		// State funeral of Queen Elizabeth II.
+		date.New(2022, time.September, 19),
+		// Coronation of King Charles III.
+		date.New(2023, time.May, 8),
+	},
+}
+
+// target2Holidays are the closing days of the TARGET2 system.
+var target2Holidays = []HolidayRule{
+	// New Year's Day.
+	FixedDate{time.January, 1},
+	// Good Friday, Easter Monday and Labour Day since 2000.
+	Years{EasterOffset{-2}, 2000, 0},
+	Years{EasterOffset{1}, 2000, 0},
+	Years{FixedDate{time.May, 1}, 2000, 0},
+	// Christmas Day.
+	FixedDate{time.December, 25},
+	// Boxing Day since 2000.
+	Years{FixedDate{time.December, 26}, 2000, 0},
+	// Special closures.
+	SpecificDates{
+		date.New(1999, time.December, 31),
+		date.New(2001, time.December, 31),
+	},
+}
+
+// euronextParisHolidays are the holidays of Euronext Paris.
+var euronextParisHolidays = []HolidayRule{
+	// New Year's Day.
+	FixedDate{time.January, 1},
+	// Good Friday.
+	EasterOffset{-2},
+	// Easter Monday.
+	EasterOffset{1},
+	// Labour Day.
+	FixedDate{time.May, 1},
+	// Christmas Day.
+	FixedDate{time.December, 25},
+	// Boxing Day.
+	FixedDate{time.December, 26},
+}
+
+// tseNationalHolidays are the national holidays of Japan, before
+// substitute and citizens' holidays are taken into account.
+var tseNationalHolidays = []HolidayRule{
+	// New Year's Day.
+	FixedDate{time.January, 1},
+	// Coming of Age Day.
+	Years{FixedDate{time.January, 15}, 0, 1999},
+	Years{NthWeekday{2, time.Monday, time.January}, 2000, 0},
+	// National Foundation Day.
+	FixedDate{time.February, 11},
+	// Emperor's Birthday.
+	Years{FixedDate{time.February, 23}, 2020, 0},
+	// Vernal and autumnal equinox days.
+	equinoxDay{time.March, 20.8431},
+	equinoxDay{time.September, 23.2488},
+	// Emperor's Birthday, Greenery Day and Showa Day.
+	FixedDate{time.April, 29},
+	// Constitution Memorial Day.
+	FixedDate{time.May, 3},
+	// Citizens' holiday and Greenery Day.
+	Years{FixedDate{time.May, 4}, 1988, 0},
+	// Children's Day.
+	FixedDate{time.May, 5},
+	// Marine Day, moved in 2020 and 2021 for the Olympic Games.
+	Years{FixedDate{time.July, 20}, 1996, 2002},
+	Years{ExceptYears{NthWeekday{3, time.Monday, time.July}, []int{2020, 2021}}, 2003, 0},
+	// Mountain Day, moved in 2020 and 2021 for the Olympic Games.
+	Years{ExceptYears{FixedDate{time.August, 11}, []int{2020, 2021}}, 2016, 0},
+	// Respect for the Aged Day.
+	Years{FixedDate{time.September, 15}, 0, 2002},
+	Years{NthWeekday{3, time.Monday, time.September}, 2003, 0},
+	// Health and Sports Day, moved in 2020 and 2021 for the Olympic Games.
+	Years{FixedDate{time.October, 10}, 0, 1999},
+	Years{ExceptYears{NthWeekday{2, time.Monday, time.October}, []int{2020, 2021}}, 2000, 0},
+	// Culture Day.
+	FixedDate{time.November, 3},
+	// Labour Thanksgiving Day.
+	FixedDate{time.November, 23},
+	// Emperor's Birthday.
+	Years{FixedDate{time.December, 23}, 1989, 2018},
+	// Moved holidays and special closures.
+	SpecificDates{
+		// Funeral of Emperor Showa.
+		date.New(1989, time.February, 24),
+		// Enthronement ceremony of Emperor Akihito.
+		date.New(1990, time.November, 12),
+		// Wedding of Crown Prince Naruhito.
+		date.New(1993, time.June, 9),
+		// Enthronement of Emperor Naruhito.
+		date.New(2019, time.April, 30),
+		date.New(2019, time.May, 1),
+		date.New(2019, time.May, 2),
+		date.New(2019, time.October, 22),
+		// Olympic Games.
+		date.New(2020, time.July, 23),
+		date.New(2020, time.July, 24),
+		date.New(2020, time.August, 10),
+		date.New(2021, time.July, 22),
+		date.New(2021, time.July, 23),
+		date.New(2021, time.August, 8),
+	},
+}
+
+// tseHolidays are the holidays of the Tokyo Stock Exchange.
+type tseHolidays struct{}
+
+// Holidays returns the holidays of the input year.
+func (r tseHolidays) Holidays(year int) []date.Date {
+	national := GenerateHolidays(year, year, tseNationalHolidays...)
+
+	isHoliday := func(d date.Date) bool {
+		i := sort.Search(len(national), func(i int) bool {
+			return !national[i].Before(d)
+		})
+
+		return i < len(national) && national[i].Equal(d)
+	}
+
+	holidays := make([]date.Date, 0, len(national)+5)
+	holidays = append(holidays, national...)
+
+	for _, holiday := range national {
+		// Since 1988, a day in between two national holidays
+		// is a citizens' holiday.
+		if year >= 1988 && isHoliday(holiday.Add(2)) {
+			holidays = append(holidays, holiday.Add(1))
+		}
+
+		if holiday.Weekday() != time.Sunday {
+			continue
+		}
+
+		// A national holiday on a Sunday is substituted by the next day.
+		// Since 2007, the substitute is the next day which is not
+		// itself a national holiday.
+		substitute := holiday.Add(1)
+		if year >= 2007 {
+			for isHoliday(substitute) {
+				substitute = substitute.Add(1)
+			}
+		}
+
+		holidays = append(holidays, substitute)
+	}
+
+	// The exchange is closed during the new year period.
+	return append(holidays,
+		date.New(year, time.January, 2),
+		date.New(year, time.January, 3),
+		date.New(year, time.December, 31),
+	)
+}
+
+// equinoxDay is a holiday occurring on a March or September equinox,
+// whose date is approximated for the years 1980 to 2099.
+type equinoxDay struct {
+	month time.Month
+	// offset is the equinox day in 1980, with its fractional part.
+	offset float64
+}
+
+// Holidays returns the equinox day of the input year.
+func (r equinoxDay) Holidays(year int) []date.Date {
+	elapsed := year - 1980
+	day := int(r.offset+0.242194*float64(elapsed)) - elapsed/4
+
+	return []date.Date{date.New(year, r.month, day)}
+}
@@ -0,0 +1,199 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgelaboratories/date"
+	"github.com/stretchr/testify/assert"
+)
+
+// inactiveWeekdays returns the weekdays of the input year
+// which are not active according to the calendar.
+func inactiveWeekdays(calendar *Calendar, year int) []date.Date {
+	var holidays []date.Date
+
+	for current := date.New(year, time.January, 1); current.Year() == year; current = current.Add(1) {
+		if w := current.Weekday(); w != time.Saturday && w != time.Sunday && !calendar.IsActive(current) {
+			holidays = append(holidays, current)
+		}
+	}
+
+	return holidays
+}
+
+func Test_MarketCalendars(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name       string
+		convention Convention
+		year       int
+		expected   []date.Date
+	}{
+		{
+			"NYSE/2022",
+			NYSE,
+			2022,
+			[]date.Date{
+				date.New(2022, time.January, 17),
+				date.New(2022, time.February, 21),
+				date.New(2022, time.April, 15),
+				date.New(2022, time.May, 30),
+				date.New(2022, time.June, 20),
+				date.New(2022, time.July, 4),
+				date.New(2022, time.September, 5),
+				date.New(2022, time.November, 24),
+				date.New(2022, time.December, 26),
+			},
+		},
+		{
+			"NYSE/2025",
+			NYSE,
+			2025,
+			[]date.Date{
+				date.New(2025, time.January, 1),
+				date.New(2025, time.January, 9),
+				date.New(2025, time.January, 20),
+				date.New(2025, time.February, 17),
+				date.New(2025, time.April, 18),
+				date.New(2025, time.May, 26),
+				date.New(2025, time.June, 19),
+				date.New(2025, time.July, 4),
+				date.New(2025, time.September, 1),
+				date.New(2025, time.November, 27),
+				date.New(2025, time.December, 25),
+			},
+		},
+		{
+			"LSE/2022",
+			LSE,
+			2022,
+			[]date.Date{
+				date.New(2022, time.January, 3),
+				date.New(2022, time.April, 15),
+				date.New(2022, time.April, 18),
+				date.New(2022, time.May, 2),
+				date.New(2022, time.June, 2),
+				date.New(2022, time.June, 3),
+				date.New(2022, time.August, 29),
+				date.New(2022, time.September, 19),
+				date.New(2022, time.December, 26),
+				date.New(2022, time.December, 27),
+			},
+		},
+		{
+			"LSE/2021",
+			LSE,
+			2021,
+			[]date.Date{
+				date.New(2021, time.January, 1),
+				date.New(2021, time.April, 2),
+				date.New(2021, time.April, 5),
+				date.New(2021, time.May, 3),
+				date.New(2021, time.May, 31),
+				date.New(2021, time.August, 30),
+				date.New(2021, time.December, 27),
+				date.New(2021, time.December, 28),
+			},
+		},
+		{
+			"TARGET2/2024",
+			TARGET2,
+			2024,
+			[]date.Date{
+				date.New(2024, time.January, 1),
+				date.New(2024, time.March, 29),
+				date.New(2024, time.April, 1),
+				date.New(2024, time.May, 1),
+				date.New(2024, time.December, 25),
+				date.New(2024, time.December, 26),
+			},
+		},
+		{
+			"TSE/2019",
+			TSE,
+			2019,
+			[]date.Date{
+				date.New(2019, time.January, 1),
+				date.New(2019, time.January, 2),
+				date.New(2019, time.January, 3),
+				date.New(2019, time.January, 14),
+				date.New(2019, time.February, 11),
+				date.New(2019, time.March, 21),
+				date.New(2019, time.April, 29),
+				date.New(2019, time.April, 30),
+				date.New(2019, time.May, 1),
+				date.New(2019, time.May, 2),
+				date.New(2019, time.May, 3),
+				date.New(2019, time.May, 6),
+				date.New(2019, time.July, 15),
+				date.New(2019, time.August, 12),
+				date.New(2019, time.September, 16),
+				date.New(2019, time.September, 23),
+				date.New(2019, time.October, 14),
+				date.New(2019, time.October, 22),
+				date.New(2019, time.November, 4),
+				date.New(2019, time.December, 31),
+			},
+		},
+		{
+			"TSE/2015",
+			TSE,
+			2015,
+			[]date.Date{
+				date.New(2015, time.January, 1),
+				date.New(2015, time.January, 2),
+				date.New(2015, time.January, 12),
+				date.New(2015, time.February, 11),
+				date.New(2015, time.April, 29),
+				date.New(2015, time.May, 4),
+				date.New(2015, time.May, 5),
+				date.New(2015, time.May, 6),
+				date.New(2015, time.July, 20),
+				date.New(2015, time.September, 21),
+				date.New(2015, time.September, 22),
+				date.New(2015, time.September, 23),
+				date.New(2015, time.October, 12),
+				date.New(2015, time.November, 3),
+				date.New(2015, time.November, 23),
+				date.New(2015, time.December, 23),
+				date.New(2015, time.December, 31),
+			},
+		},
+		{
+			"EuronextParis/2024",
+			EuronextParis,
+			2024,
+			[]date.Date{
+				date.New(2024, time.January, 1),
+				date.New(2024, time.March, 29),
+				date.New(2024, time.April, 1),
+				date.New(2024, time.May, 1),
+				date.New(2024, time.December, 25),
+				date.New(2024, time.December, 26),
+			},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			calendar := New(tc.convention)
+
+			assert.Equal(t, tc.convention, calendar.Convention())
+			assert.Equal(t, tc.expected, inactiveWeekdays(calendar, tc.year))
+		})
+	}
+}
+
+func Test_MarketCalendars_OutsideRange(t *testing.T) {
+	t.Parallel()
+
+	calendar := New(NYSE)
+
+	assert.True(t, calendar.IsActive(date.New(2100, time.December, 24)))
+	assert.False(t, calendar.IsActive(date.New(2099, time.December, 25)))
+	assert.Equal(t, date.New(2100, time.December, 27), calendar.Next(date.New(2100, time.December, 24)))
+}
@@ -0,0 +1,51 @@
+package calendar
+
+import (
+	"time"
+
+	"github.com/edgelaboratories/date"
+)
+
+// StartOfMonth returns the first active date of the month
+// of the input date.
+func (c *Calendar) StartOfMonth(d date.Date) date.Date {
+	return c.Next(date.New(d.Year(), d.Month(), 0))
+}
+
+// EndOfMonth returns the last active date of the month
+// of the input date.
+func (c *Calendar) EndOfMonth(d date.Date) date.Date {
+	return c.LatestBefore(lastDayOfMonth(d.Year(), d.Month()))
+}
+
+// EndOfQuarter returns the last active date of the quarter
+// of the input date.
+func (c *Calendar) EndOfQuarter(d date.Date) date.Date {
+	month := (d.Month()-1)/3*3 + 3
+
+	return c.LatestBefore(lastDayOfMonth(d.Year(), month))
+}
+
+// EndOfYear returns the last active date of the year
+// of the input date.
+func (c *Calendar) EndOfYear(d date.Date) date.Date {
+	return c.LatestBefore(date.New(d.Year(), time.December, 31))
+}
+
+// IsEndOfMonth returns true if the input date is the last
+// active date of its month.
+func (c *Calendar) IsEndOfMonth(d date.Date) bool {
+	return c.IsActive(d) && c.EndOfMonth(d).Equal(d)
+}
+
+// AddPeriodEndOfMonth adds the input period to the origin date as AddPeriod
+// does, but applies the end-of-month rule: when the origin date is the last
+// active date of its month, adding months or years returns the last active
+// date of the target month.
+func (c *Calendar) AddPeriodEndOfMonth(origin date.Date, period Period, adjustment Adjustment) date.Date {
+	if period.isMonthly() && c.IsEndOfMonth(origin) {
+		return c.EndOfMonth(period.addTo(origin, 1))
+	}
+
+	return c.AddPeriod(origin, period, adjustment)
+}
@@ -0,0 +1,116 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgelaboratories/date"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Calendar_MonthEnds(t *testing.T) {
+	t.Parallel()
+
+	calendar := New(BusinessDays)
+
+	for _, tc := range []struct {
+		date         date.Date
+		startOfMonth date.Date
+		endOfMonth   date.Date
+		endOfQuarter date.Date
+		endOfYear    date.Date
+	}{
+		{
+			date.New(2021, time.May, 15),
+			date.New(2021, time.May, 3),
+			date.New(2021, time.May, 31),
+			date.New(2021, time.June, 30),
+			date.New(2021, time.December, 31),
+		},
+		{
+			date.New(2021, time.July, 1),
+			date.New(2021, time.July, 1),
+			date.New(2021, time.July, 30),
+			date.New(2021, time.September, 30),
+			date.New(2021, time.December, 31),
+		},
+		{
+			date.New(2022, time.December, 31),
+			date.New(2022, time.December, 1),
+			date.New(2022, time.December, 30),
+			date.New(2022, time.December, 30),
+			date.New(2022, time.December, 30),
+		},
+		{
+			date.New(2021, time.February, 10),
+			date.New(2021, time.February, 1),
+			date.New(2021, time.February, 26),
+			date.New(2021, time.March, 31),
+			date.New(2021, time.December, 31),
+		},
+	} {
+		assert.Equal(t, tc.startOfMonth, calendar.StartOfMonth(tc.date))
+		assert.Equal(t, tc.endOfMonth, calendar.EndOfMonth(tc.date))
+		assert.Equal(t, tc.endOfQuarter, calendar.EndOfQuarter(tc.date))
+		assert.Equal(t, tc.endOfYear, calendar.EndOfYear(tc.date))
+	}
+}
+
+func Test_Calendar_IsEndOfMonth(t *testing.T) {
+	t.Parallel()
+
+	calendar := New(BusinessDays)
+
+	assert.False(t, calendar.IsEndOfMonth(date.New(2021, time.July, 29)))
+	assert.True(t, calendar.IsEndOfMonth(date.New(2021, time.July, 30)))
+	assert.False(t, calendar.IsEndOfMonth(date.New(2021, time.July, 31)))
+	assert.True(t, New(CalendarDays).IsEndOfMonth(date.New(2021, time.July, 31)))
+}
+
+func Test_Calendar_AddPeriodEndOfMonth(t *testing.T) {
+	t.Parallel()
+
+	calendar := New(BusinessDays)
+
+	for _, tc := range []struct {
+		origin   date.Date
+		period   Period
+		expected date.Date
+	}{
+		{
+			date.New(2021, time.April, 30),
+			Period{1, PeriodMonths},
+			date.New(2021, time.May, 31),
+		},
+		{
+			date.New(2021, time.April, 30),
+			Period{3, PeriodMonths},
+			date.New(2021, time.July, 30),
+		},
+		{
+			// February 28th is a Sunday.
+			date.New(2021, time.February, 26),
+			Period{1, PeriodMonths},
+			date.New(2021, time.March, 31),
+		},
+		{
+			date.New(2021, time.February, 26),
+			Period{-1, PeriodYears},
+			date.New(2020, time.February, 28),
+		},
+		{
+			// Not the end of the month.
+			date.New(2021, time.April, 28),
+			Period{1, PeriodMonths},
+			date.New(2021, time.May, 28),
+		},
+		{
+			// Not a monthly period.
+			date.New(2021, time.April, 30),
+			Period{1, PeriodWeeks},
+			date.New(2021, time.May, 7),
+		},
+	} {
+		assert.Equal(t, tc.expected, calendar.AddPeriodEndOfMonth(tc.origin, tc.period, Following))
+	}
+}
@@ -0,0 +1,161 @@
+package calendar
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/edgelaboratories/date"
+)
+
+// PeriodUnit defines the unit of a period.
+type PeriodUnit string
+
+const (
+	// PeriodDays measures periods in calendar days.
+	PeriodDays PeriodUnit = "D"
+	// PeriodWeeks measures periods in weeks.
+	PeriodWeeks PeriodUnit = "W"
+	// PeriodMonths measures periods in months.
+	PeriodMonths PeriodUnit = "M"
+	// PeriodYears measures periods in years.
+	PeriodYears PeriodUnit = "Y"
+	// PeriodBusinessDays measures periods in active days of a calendar.
+	PeriodBusinessDays PeriodUnit = "BD"
+)
+
+// ErrInvalidPeriod is returned when a period can't be parsed.
+var ErrInvalidPeriod = errors.New("calendar: invalid period")
+
+// Period is a length of time expressed in a given unit, such as 3M.
+type Period struct {
+	Length int
+	Unit   PeriodUnit
+}
+
+// ParsePeriod parses a period expressed in market tenor notation,
+// that is a possibly negative integer followed by a unit among D, W,
+// M, Y and BD (business days), such as 2D, 3M, 1Y or 2BD.
+// Parsing is case-insensitive. The following tenors are accepted as well:
+//   - ON (overnight), which is equivalent to 1BD;
+//   - TN (tomorrow-next), which is equivalent to 2BD;
+//   - SPOT, which is equivalent to 2BD.
+func ParsePeriod(value string) (Period, error) {
+	tenor := strings.ToUpper(strings.TrimSpace(value))
+
+	switch tenor {
+	case "ON":
+		return Period{1, PeriodBusinessDays}, nil
+	case "TN", "SPOT":
+		return Period{2, PeriodBusinessDays}, nil
+	}
+
+	// Split the length from the unit.
+	i := strings.IndexFunc(tenor, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '-' && r != '+'
+	})
+	if i <= 0 {
+		return Period{}, fmt.Errorf("%w %q", ErrInvalidPeriod, value)
+	}
+
+	length, err := strconv.Atoi(tenor[:i])
+	if err != nil {
+		return Period{}, fmt.Errorf("%w %q", ErrInvalidPeriod, value)
+	}
+
+	unit := PeriodUnit(tenor[i:])
+
+	switch unit {
+	case PeriodDays, PeriodWeeks, PeriodMonths, PeriodYears, PeriodBusinessDays:
+		return Period{length, unit}, nil
+	}
+
+	return Period{}, fmt.Errorf("%w %q", ErrInvalidPeriod, value)
+}
+
+// String returns the period in tenor notation, such as 3M.
+func (p Period) String() string {
+	return fmt.Sprintf("%d%s", p.Length, p.Unit)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (p Period) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (p *Period) UnmarshalText(data []byte) error {
+	period, err := ParsePeriod(string(data))
+	if err != nil {
+		return err
+	}
+
+	*p = period
+
+	return nil
+}
+
+// AddPeriod adds the input period to the origin date, and adjusts
+// the result according to the adjustment convention.
+// Business days are added as active days of the calendar. Adding months
+// or years to a date whose day doesn't exist in the target month lands
+// on the last day of that month, before adjustment.
+func (c *Calendar) AddPeriod(origin date.Date, period Period, adjustment Adjustment) date.Date {
+	if period.Unit == PeriodBusinessDays {
+		return c.Add(origin, period.Length)
+	}
+
+	return c.Adjust(period.addTo(origin, 1), adjustment)
+}
+
+// isMonthly returns true if the period is expressed in months or years.
+func (p Period) isMonthly() bool {
+	return p.Unit == PeriodMonths || p.Unit == PeriodYears
+}
+
+// addTo adds the period, multiplied by the input factor, to the input date.
+// Adding months or years to a date whose day doesn't exist in the target
+// month returns the last day of that month, so that January 31st plus one
+// month is February 28th or 29th.
+func (p Period) addTo(origin date.Date, factor int) date.Date {
+	length := p.Length * factor
+
+	switch p.Unit {
+	case PeriodDays:
+		return origin.Add(length)
+
+	case PeriodWeeks:
+		return origin.Add(7 * length)
+
+	case PeriodMonths:
+		return addMonths(origin, length)
+
+	case PeriodYears:
+		return addMonths(origin, 12*length)
+
+	case PeriodBusinessDays:
+		// Business days can't be added without a calendar.
+	}
+
+	return origin
+}
+
+// addMonths adds a number of months to the input date, keeping the
+// result within the target month.
+func addMonths(origin date.Date, months int) date.Date {
+	year, month, day := origin.Date()
+
+	if last := lastDayOfMonth(year, month+time.Month(months)); day > last.Day() {
+		return last
+	}
+
+	return date.New(year, month+time.Month(months), day)
+}
+
+// lastDayOfMonth returns the last day of the input month,
+// which is normalized when out of its usual range.
+func lastDayOfMonth(year int, month time.Month) date.Date {
+	return date.New(year, month+1, 0)
+}
@@ -0,0 +1,181 @@
+package calendar
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/edgelaboratories/date"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Period_String(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "3M", Period{3, PeriodMonths}.String())
+	assert.Equal(t, "1Y", Period{1, PeriodYears}.String())
+}
+
+func Test_Period_addTo(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		period   Period
+		origin   date.Date
+		factor   int
+		expected date.Date
+	}{
+		{
+			Period{2, PeriodDays},
+			date.New(2021, time.December, 31),
+			1,
+			date.New(2022, time.January, 2),
+		},
+		{
+			Period{1, PeriodWeeks},
+			date.New(2021, time.December, 31),
+			-2,
+			date.New(2021, time.December, 17),
+		},
+		{
+			Period{1, PeriodMonths},
+			date.New(2021, time.January, 31),
+			1,
+			date.New(2021, time.February, 28),
+		},
+		{
+			Period{1, PeriodMonths},
+			date.New(2020, time.January, 31),
+			1,
+			date.New(2020, time.February, 29),
+		},
+		{
+			Period{3, PeriodMonths},
+			date.New(2021, time.March, 31),
+			-3,
+			date.New(2020, time.June, 30),
+		},
+		{
+			Period{1, PeriodYears},
+			date.New(2020, time.February, 29),
+			1,
+			date.New(2021, time.February, 28),
+		},
+		{
+			Period{1, PeriodUnit("X")},
+			date.New(2020, time.February, 29),
+			1,
+			date.New(2020, time.February, 29),
+		},
+	} {
+		assert.Equal(t, tc.expected, tc.period.addTo(tc.origin, tc.factor))
+	}
+}
+
+func Test_ParsePeriod(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		value    string
+		expected Period
+	}{
+		{"2D", Period{2, PeriodDays}},
+		{"1W", Period{1, PeriodWeeks}},
+		{"3M", Period{3, PeriodMonths}},
+		{"1Y", Period{1, PeriodYears}},
+		{"2BD", Period{2, PeriodBusinessDays}},
+		{"-2BD", Period{-2, PeriodBusinessDays}},
+		{" 6m ", Period{6, PeriodMonths}},
+		{"ON", Period{1, PeriodBusinessDays}},
+		{"TN", Period{2, PeriodBusinessDays}},
+		{"Spot", Period{2, PeriodBusinessDays}},
+	} {
+		period, err := ParsePeriod(tc.value)
+		require.NoError(t, err)
+		assert.Equal(t, tc.expected, period)
+	}
+
+	for _, value := range []string{
+		"",
+		"M",
+		"3",
+		"3X",
+		"1-M",
+		"1Y6M",
+	} {
+		_, err := ParsePeriod(value)
+		assert.ErrorIs(t, err, ErrInvalidPeriod)
+	}
+}
+
+func Test_Period_UnmarshalText(t *testing.T) {
+	t.Parallel()
+
+	var periods []Period
+
+	require.NoError(t, json.Unmarshal([]byte(`["3M", "2BD"]`), &periods))
+	assert.Equal(t, []Period{{3, PeriodMonths}, {2, PeriodBusinessDays}}, periods)
+
+	data, err := json.Marshal(periods)
+	require.NoError(t, err)
+	assert.JSONEq(t, `["3M", "2BD"]`, string(data))
+
+	assert.Error(t, json.Unmarshal([]byte(`["3X"]`), &periods))
+}
+
+func Test_Calendar_AddPeriod(t *testing.T) {
+	t.Parallel()
+
+	calendar := New(BusinessDays)
+
+	for _, tc := range []struct {
+		origin     date.Date
+		period     Period
+		adjustment Adjustment
+		expected   date.Date
+	}{
+		{
+			// Friday plus two business days is Tuesday.
+			date.New(2021, time.July, 30),
+			Period{2, PeriodBusinessDays},
+			Unadjusted,
+			date.New(2021, time.August, 3),
+		},
+		{
+			// Friday plus one day is Saturday.
+			date.New(2021, time.July, 30),
+			Period{1, PeriodDays},
+			Unadjusted,
+			date.New(2021, time.July, 31),
+		},
+		{
+			date.New(2021, time.July, 30),
+			Period{1, PeriodDays},
+			Following,
+			date.New(2021, time.August, 2),
+		},
+		{
+			// June 30th plus one month is July 30th, a Friday.
+			date.New(2021, time.June, 30),
+			Period{1, PeriodMonths},
+			ModifiedFollowing,
+			date.New(2021, time.July, 30),
+		},
+		{
+			// August 31st minus six months is February 28th, a Sunday.
+			date.New(2021, time.August, 31),
+			Period{-6, PeriodMonths},
+			ModifiedFollowing,
+			date.New(2021, time.February, 26),
+		},
+		{
+			date.New(2021, time.July, 31),
+			Period{1, PeriodYears},
+			Preceding,
+			date.New(2022, time.July, 29),
+		},
+	} {
+		assert.Equal(t, tc.expected, calendar.AddPeriod(tc.origin, tc.period, tc.adjustment))
+	}
+}
@@ -40,3 +40,14 @@ func (c physicalCalendar) Add(origin date.Date, days int) date.Date {
 func (c physicalCalendar) DaysBetween(from, to date.Date) int {
 	return to.Sub(from)
 }
+
+// isWeekend returns false, as there are no weekend days
+// in the physical calendar.
+func (c physicalCalendar) isWeekend(date.Date) bool {
+	return false
+}
+
+// describe returns the description of the calendar.
+func (c physicalCalendar) describe() (Description, error) {
+	return Description{Convention: CalendarDays}, nil
+}
@@ -0,0 +1,47 @@
+package calendar
+
+import (
+	"iter"
+
+	"github.com/edgelaboratories/date"
+)
+
+// ActiveDays returns an iterator over the active dates between from
+// (excluded) and to (included), consistently with DaysBetween.
+// Dates are yielded in ascending order when from is not after to.
+// Otherwise, the active dates between to (excluded) and from (included)
+// are yielded in descending order.
+func (c *Calendar) ActiveDays(from, to date.Date) iter.Seq[date.Date] {
+	if from.After(to) {
+		return func(yield func(date.Date) bool) {
+			for current := c.LatestBefore(from); current.After(to); current = c.Previous(current) {
+				if !yield(current) {
+					return
+				}
+			}
+		}
+	}
+
+	return func(yield func(date.Date) bool) {
+		for current := c.Next(from); !current.After(to); current = c.Next(current) {
+			if !yield(current) {
+				return
+			}
+		}
+	}
+}
+
+// ActiveDaysSlice returns the active dates yielded by ActiveDays.
+func (c *Calendar) ActiveDaysSlice(from, to date.Date) []date.Date {
+	days := c.DaysBetween(from, to)
+	if days < 0 {
+		days = -days
+	}
+
+	dates := make([]date.Date, 0, days)
+	for current := range c.ActiveDays(from, to) {
+		dates = append(dates, current)
+	}
+
+	return dates
+}
@@ -0,0 +1,117 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgelaboratories/date"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Calendar_ActiveDays(t *testing.T) {
+	t.Parallel()
+
+	var (
+		calendar = NewWithHolidays(easterHolidays2021...)
+		// Dates range from Wednesday to Wednesday, across Easter.
+		wednesday     = date.New(2021, time.March, 31)
+		nextWednesday = date.New(2021, time.April, 7)
+	)
+
+	for _, tc := range []struct {
+		name     string
+		from     date.Date
+		to       date.Date
+		expected []date.Date
+	}{
+		{
+			"ascending",
+			wednesday,
+			nextWednesday,
+			[]date.Date{
+				date.New(2021, time.April, 1),
+				date.New(2021, time.April, 6),
+				date.New(2021, time.April, 7),
+			},
+		},
+		{
+			"descending",
+			nextWednesday,
+			wednesday,
+			[]date.Date{
+				date.New(2021, time.April, 7),
+				date.New(2021, time.April, 6),
+				date.New(2021, time.April, 1),
+			},
+		},
+		{
+			"ascending/inactive bounds",
+			date.New(2021, time.April, 2),
+			date.New(2021, time.April, 5),
+			[]date.Date{},
+		},
+		{
+			"ascending/from inactive",
+			date.New(2021, time.April, 2),
+			date.New(2021, time.April, 6),
+			[]date.Date{
+				date.New(2021, time.April, 6),
+			},
+		},
+		{
+			"descending/from inactive",
+			date.New(2021, time.April, 5),
+			date.New(2021, time.March, 31),
+			[]date.Date{
+				date.New(2021, time.April, 1),
+			},
+		},
+		{
+			"empty",
+			wednesday,
+			wednesday,
+			[]date.Date{},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := calendar.ActiveDaysSlice(tc.from, tc.to)
+
+			assert.Equal(t, tc.expected, actual)
+			assert.Len(t, actual, abs(calendar.DaysBetween(tc.from, tc.to)))
+		})
+	}
+}
+
+func Test_Calendar_ActiveDays_Break(t *testing.T) {
+	t.Parallel()
+
+	var (
+		calendar = New(BusinessDays)
+		from     = date.New(2021, time.January, 1)
+		to       = date.New(2021, time.December, 31)
+	)
+
+	for _, bounds := range [][2]date.Date{{from, to}, {to, from}} {
+		count := 0
+		for range calendar.ActiveDays(bounds[0], bounds[1]) {
+			count++
+			if count == 3 {
+				break
+			}
+		}
+
+		assert.Equal(t, 3, count)
+	}
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+
+	return x
+}
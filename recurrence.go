@@ -0,0 +1,434 @@
+package calendar
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/edgelaboratories/date"
+)
+
+// frequency is the frequency of a recurrence rule.
+type frequency string
+
+const (
+	daily   frequency = "DAILY"
+	weekly  frequency = "WEEKLY"
+	monthly frequency = "MONTHLY"
+	yearly  frequency = "YEARLY"
+)
+
+// icsWeekdays maps the iCalendar weekday codes to weekdays.
+var icsWeekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// recurrenceWeekday is a BYDAY value, such as MO, 3TH or -1FR.
+type recurrenceWeekday struct {
+	// ordinal is the rank of the weekday within the month or the year,
+	// counted backward when negative. Zero stands for every such weekday.
+	ordinal int
+	weekday time.Weekday
+}
+
+// byDay matches the BYDAY values.
+var byDay = regexp.MustCompile(`^([+-]?\d{1,2})?(SU|MO|TU|WE|TH|FR|SA)$`)
+
+// recurrenceRule is a RRULE value, restricted to date recurrences.
+type recurrenceRule struct {
+	frequency frequency
+	interval  int
+	count     int
+	until     *date.Date
+	months    []time.Month
+	monthDays []int
+	weekdays  []recurrenceWeekday
+}
+
+// parseRecurrenceRule parses a RRULE value, such as
+// FREQ=YEARLY;BYMONTH=11;BYDAY=4TH.
+func parseRecurrenceRule(value string) (recurrenceRule, error) {
+	rule := recurrenceRule{interval: 1}
+
+	for _, part := range strings.Split(value, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return recurrenceRule{}, fmt.Errorf("invalid RRULE part %q", part)
+		}
+
+		if err := rule.set(strings.ToUpper(key), strings.ToUpper(value)); err != nil {
+			return recurrenceRule{}, err
+		}
+	}
+
+	return rule, rule.validate()
+}
+
+// set sets the input part of the rule.
+func (r *recurrenceRule) set(key, value string) error {
+	var err error
+
+	switch key {
+	case "FREQ":
+		r.frequency = frequency(value)
+
+	case "INTERVAL":
+		r.interval, err = parsePositive(key, value)
+
+	case "COUNT":
+		r.count, err = parsePositive(key, value)
+
+	case "UNTIL":
+		var until icsTime
+
+		until, err = parseICSTime(value)
+		r.until = &until.date
+
+	case "BYMONTH":
+		err = parseList(key, value, func(month int) error {
+			if month < 1 || month > 12 {
+				return fmt.Errorf("invalid BYMONTH value %d", month)
+			}
+
+			r.months = append(r.months, time.Month(month))
+
+			return nil
+		})
+
+	case "BYMONTHDAY":
+		err = parseList(key, value, func(day int) error {
+			if day == 0 || day < -31 || day > 31 {
+				return fmt.Errorf("invalid BYMONTHDAY value %d", day)
+			}
+
+			r.monthDays = append(r.monthDays, day)
+
+			return nil
+		})
+
+	case "BYDAY":
+		for _, item := range strings.Split(value, ",") {
+			matches := byDay.FindStringSubmatch(item)
+			if matches == nil {
+				return fmt.Errorf("invalid BYDAY value %q", item)
+			}
+
+			weekday := recurrenceWeekday{weekday: icsWeekdays[matches[2]]}
+			if matches[1] != "" {
+				weekday.ordinal, _ = strconv.Atoi(matches[1])
+				if weekday.ordinal == 0 {
+					return fmt.Errorf("invalid BYDAY value %q", item)
+				}
+			}
+
+			r.weekdays = append(r.weekdays, weekday)
+		}
+
+	case "WKST":
+		// Weeks are assumed to start on Monday, which only matters
+		// for weekly rules with an interval and several weekdays.
+		if _, ok := icsWeekdays[value]; !ok {
+			return fmt.Errorf("invalid WKST value %q", value)
+		}
+
+	default:
+		return fmt.Errorf("unsupported RRULE part %q", key)
+	}
+
+	return err
+}
+
+// validate checks the consistency of the rule parts.
+func (r *recurrenceRule) validate() error {
+	switch r.frequency {
+	case daily, weekly:
+		for _, weekday := range r.weekdays {
+			if weekday.ordinal != 0 {
+				return fmt.Errorf("BYDAY ordinals are not allowed with FREQ=%s", r.frequency)
+			}
+		}
+
+		if r.frequency == weekly && len(r.monthDays) != 0 {
+			return errors.New("BYMONTHDAY is not allowed with FREQ=WEEKLY")
+		}
+
+	case monthly, yearly:
+
+	default:
+		if r.frequency == "" {
+			return errors.New("RRULE without FREQ")
+		}
+
+		return fmt.Errorf("unsupported RRULE frequency %q", string(r.frequency))
+	}
+
+	if r.count != 0 && r.until != nil {
+		return errors.New("RRULE with both COUNT and UNTIL")
+	}
+
+	return nil
+}
+
+// parsePositive parses the positive integer value of a rule part.
+func parsePositive(key, value string) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("invalid %s value %q", key, value)
+	}
+
+	return n, nil
+}
+
+// parseList parses the comma-separated integer values of a rule part.
+func parseList(key, value string, add func(int) error) error {
+	for _, item := range strings.Split(value, ",") {
+		n, err := strconv.Atoi(item)
+		if err != nil {
+			return fmt.Errorf("invalid %s value %q", key, item)
+		}
+
+		if err := add(n); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// occurrences returns the dates of the occurrences of the rule starting
+// at the input date, in increasing order, up to the input date.
+func (r recurrenceRule) occurrences(start, to date.Date) iter.Seq[date.Date] {
+	return func(yield func(date.Date) bool) {
+		last := to
+		if r.until != nil && r.until.Before(last) {
+			last = *r.until
+		}
+
+		count := 0
+
+		for period := 0; ; period += r.interval {
+			first, candidates := r.candidates(start, period)
+			if first.After(last) {
+				return
+			}
+
+			for _, candidate := range candidates {
+				if candidate.Before(start) {
+					continue
+				}
+
+				count++
+				if candidate.After(last) || (r.count != 0 && count > r.count) || !yield(candidate) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// candidates returns the first date of the nth period following the
+// start date, along with the sorted dates of the period matching the rule.
+func (r recurrenceRule) candidates(start date.Date, n int) (date.Date, []date.Date) {
+	switch r.frequency {
+	case daily:
+		current := start.Add(n)
+		if !r.hasMonth(current) || !r.hasMonthDay(current) || !r.hasWeekday(current) {
+			return current, nil
+		}
+
+		return current, []date.Date{current}
+
+	case weekly:
+		// Weeks start on Monday.
+		sinceMonday := (int(start.Weekday()) - int(time.Monday) + daysInWeek) % daysInWeek
+		monday := start.Add(daysInWeek*n - sinceMonday)
+
+		dates := []date.Date{}
+		for i := 0; i < daysInWeek; i++ {
+			current := monday.Add(i)
+			if !r.hasMonth(current) {
+				continue
+			}
+
+			if (len(r.weekdays) == 0 && current.Weekday() == start.Weekday()) || (len(r.weekdays) != 0 && r.hasWeekday(current)) {
+				dates = append(dates, current)
+			}
+		}
+
+		return monday, dates
+
+	case monthly:
+		first := addMonths(date.New(start.Year(), start.Month(), 1), n)
+		if !r.hasMonth(first) {
+			return first, nil
+		}
+
+		return first, r.monthCandidates(first, start.Day())
+
+	case yearly:
+		first := date.New(start.Year()+n, time.January, 1)
+
+		if len(r.weekdays) != 0 && len(r.months) == 0 && len(r.monthDays) == 0 {
+			return first, r.weekdaysBetween(first, date.New(first.Year(), time.December, 31))
+		}
+
+		months := r.months
+		if len(months) == 0 {
+			months = []time.Month{start.Month()}
+			if len(r.monthDays) != 0 || len(r.weekdays) != 0 {
+				months = []time.Month{
+					time.January, time.February, time.March, time.April, time.May, time.June,
+					time.July, time.August, time.September, time.October, time.November, time.December,
+				}
+			}
+		}
+
+		dates := []date.Date{}
+		for _, month := range months {
+			dates = append(dates, r.monthCandidates(date.New(first.Year(), month, 1), start.Day())...)
+		}
+
+		return first, sortDates(dates)
+
+	default:
+		return start, nil
+	}
+}
+
+// monthCandidates returns the sorted dates of the month starting at the
+// input date matching the rule, defaulting to the input day of the month.
+func (r recurrenceRule) monthCandidates(first date.Date, day int) []date.Date {
+	last := lastDayOfMonth(first.Year(), first.Month())
+
+	switch {
+	case len(r.monthDays) != 0:
+		dates := []date.Date{}
+
+		for _, monthDay := range r.monthDays {
+			if monthDay < 0 {
+				monthDay += last.Day() + 1
+			}
+
+			if monthDay >= 1 && monthDay <= last.Day() {
+				current := first.Add(monthDay - 1)
+				if r.hasWeekday(current) {
+					dates = append(dates, current)
+				}
+			}
+		}
+
+		return sortDates(dates)
+
+	case len(r.weekdays) != 0:
+		return r.weekdaysBetween(first, last)
+
+	default:
+		if day > last.Day() {
+			return nil
+		}
+
+		return []date.Date{first.Add(day - 1)}
+	}
+}
+
+// weekdaysBetween returns the sorted dates between first and last (both
+// included) matching the BYDAY values, ordinals being counted in the range.
+func (r recurrenceRule) weekdaysBetween(first, last date.Date) []date.Date {
+	dates := []date.Date{}
+
+	for _, weekday := range r.weekdays {
+		matching := []date.Date{}
+
+		current := first.Add((int(weekday.weekday) - int(first.Weekday()) + daysInWeek) % daysInWeek)
+		for ; !current.After(last); current = current.Add(daysInWeek) {
+			matching = append(matching, current)
+		}
+
+		switch {
+		case weekday.ordinal == 0:
+			dates = append(dates, matching...)
+		case weekday.ordinal > 0 && weekday.ordinal <= len(matching):
+			dates = append(dates, matching[weekday.ordinal-1])
+		case weekday.ordinal < 0 && -weekday.ordinal <= len(matching):
+			dates = append(dates, matching[len(matching)+weekday.ordinal])
+		}
+	}
+
+	return sortDates(dates)
+}
+
+// hasMonth returns true if the input date matches the BYMONTH values, if any.
+func (r recurrenceRule) hasMonth(d date.Date) bool {
+	if len(r.months) == 0 {
+		return true
+	}
+
+	for _, month := range r.months {
+		if d.Month() == month {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasMonthDay returns true if the input date matches the BYMONTHDAY
+// values, if any.
+func (r recurrenceRule) hasMonthDay(d date.Date) bool {
+	if len(r.monthDays) == 0 {
+		return true
+	}
+
+	last := lastDayOfMonth(d.Year(), d.Month()).Day()
+
+	for _, monthDay := range r.monthDays {
+		if d.Day() == monthDay || d.Day() == last+monthDay+1 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasWeekday returns true if the weekday of the input date matches
+// the BYDAY values, if any, regardless of their ordinals.
+func (r recurrenceRule) hasWeekday(d date.Date) bool {
+	if len(r.weekdays) == 0 {
+		return true
+	}
+
+	for _, weekday := range r.weekdays {
+		if d.Weekday() == weekday.weekday {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sortDates sorts the input dates and removes the duplicates.
+func sortDates(dates []date.Date) []date.Date {
+	sort.Slice(dates, func(i, j int) bool {
+		return dates[i].Before(dates[j])
+	})
+
+	unique := dates[:0]
+	for _, d := range dates {
+		if len(unique) == 0 || !d.Equal(unique[len(unique)-1]) {
+			unique = append(unique, d)
+		}
+	}
+
+	return unique
+}
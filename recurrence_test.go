@@ -0,0 +1,165 @@
+package calendar
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/edgelaboratories/date"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_recurrenceRule_occurrences(t *testing.T) {
+	t.Parallel()
+
+	var (
+		start = date.New(2021, time.January, 4)
+		to    = date.New(2022, time.December, 31)
+	)
+
+	for _, tc := range []struct {
+		name     string
+		rule     string
+		start    date.Date
+		expected []date.Date
+	}{
+		{
+			"daily with count",
+			"FREQ=DAILY;COUNT=3",
+			start,
+			[]date.Date{start, start.Add(1), start.Add(2)},
+		},
+		{
+			"daily on weekdays",
+			"FREQ=DAILY;BYDAY=SA,SU;UNTIL=20210117",
+			start,
+			[]date.Date{
+				date.New(2021, time.January, 9),
+				date.New(2021, time.January, 10),
+				date.New(2021, time.January, 16),
+				date.New(2021, time.January, 17),
+			},
+		},
+		{
+			"weekly with interval",
+			"FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR;COUNT=4;WKST=MO",
+			start,
+			[]date.Date{
+				date.New(2021, time.January, 4),
+				date.New(2021, time.January, 8),
+				date.New(2021, time.January, 18),
+				date.New(2021, time.January, 22),
+			},
+		},
+		{
+			"weekly on start weekday",
+			"FREQ=WEEKLY;COUNT=2",
+			date.New(2021, time.January, 6),
+			[]date.Date{date.New(2021, time.January, 6), date.New(2021, time.January, 13)},
+		},
+		{
+			"monthly on last day",
+			"FREQ=MONTHLY;BYMONTHDAY=-1;COUNT=3",
+			start,
+			[]date.Date{
+				date.New(2021, time.January, 31),
+				date.New(2021, time.February, 28),
+				date.New(2021, time.March, 31),
+			},
+		},
+		{
+			"monthly skipping short months",
+			"FREQ=MONTHLY;COUNT=3",
+			date.New(2021, time.January, 31),
+			[]date.Date{
+				date.New(2021, time.January, 31),
+				date.New(2021, time.March, 31),
+				date.New(2021, time.May, 31),
+			},
+		},
+		{
+			"monthly on first friday of quarters",
+			"FREQ=MONTHLY;BYMONTH=3,6,9,12;BYDAY=1FR;UNTIL=20211231T235959Z",
+			start,
+			[]date.Date{
+				date.New(2021, time.March, 5),
+				date.New(2021, time.June, 4),
+				date.New(2021, time.September, 3),
+				date.New(2021, time.December, 3),
+			},
+		},
+		{
+			"yearly on fixed date",
+			"FREQ=YEARLY",
+			date.New(2020, time.July, 4),
+			[]date.Date{
+				date.New(2020, time.July, 4),
+				date.New(2021, time.July, 4),
+				date.New(2022, time.July, 4),
+			},
+		},
+		{
+			"yearly on leap day",
+			"FREQ=YEARLY",
+			date.New(2016, time.February, 29),
+			[]date.Date{date.New(2016, time.February, 29), date.New(2020, time.February, 29)},
+		},
+		{
+			"yearly on last monday of may",
+			"FREQ=YEARLY;BYMONTH=5;BYDAY=-1MO",
+			start,
+			[]date.Date{date.New(2021, time.May, 31), date.New(2022, time.May, 30)},
+		},
+		{
+			"yearly on friday the 13th",
+			"FREQ=YEARLY;BYMONTHDAY=13;BYDAY=FR;UNTIL=20221231",
+			start,
+			[]date.Date{date.New(2021, time.August, 13), date.New(2022, time.May, 13)},
+		},
+		{
+			"yearly on first monday of the year",
+			"FREQ=YEARLY;BYDAY=1MO;INTERVAL=2",
+			start,
+			[]date.Date{date.New(2021, time.January, 4)},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			rule, err := parseRecurrenceRule(tc.rule)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expected, slices.Collect(rule.occurrences(tc.start, to)))
+		})
+	}
+}
+
+func Test_parseRecurrenceRule_Errors(t *testing.T) {
+	t.Parallel()
+
+	for _, value := range []string{
+		"",
+		"COUNT=1",
+		"FREQ=SECONDLY",
+		"FREQ=DAILY;INTERVAL=0",
+		"FREQ=DAILY;COUNT=x",
+		"FREQ=DAILY;COUNT=2;UNTIL=20210101",
+		"FREQ=DAILY;UNTIL=2021",
+		"FREQ=YEARLY;BYMONTH=13",
+		"FREQ=YEARLY;BYMONTHDAY=0",
+		"FREQ=YEARLY;BYMONTHDAY=32",
+		"FREQ=YEARLY;BYDAY=XX",
+		"FREQ=YEARLY;BYDAY=0MO",
+		"FREQ=WEEKLY;BYDAY=2MO",
+		"FREQ=WEEKLY;BYMONTHDAY=1",
+		"FREQ=YEARLY;BYSETPOS=1",
+		"FREQ=YEARLY;WKST=XX",
+		"FREQ",
+	} {
+		_, err := parseRecurrenceRule(value)
+		assert.Error(t, err, value)
+	}
+}
@@ -0,0 +1,132 @@
+package calendar
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ErrAlreadyRegistered is returned when registering a convention
+// which is already supported.
+var ErrAlreadyRegistered = errors.New("calendar: convention already registered")
+
+// Factory returns a new calendar. It must not return nil.
+type Factory func() *Calendar
+
+// registry holds the factories of the registered conventions.
+var registry = struct {
+	sync.RWMutex
+	factories map[Convention]Factory
+}{
+	factories: map[Convention]Factory{},
+}
+
+// Register makes the calendars returned by the input factory available
+// under the input convention, exactly like the built-in ones: New and
+// NewStrict return them, and the convention is accepted by ParseConvention
+// and listed by Conventions. The calendars' Convention method returns
+// the registered convention.
+// ErrAlreadyRegistered is returned if the convention is already supported,
+// regardless of case.
+// Register is safe for concurrent use.
+func Register(convention Convention, factory Factory) error {
+	if convention == "" || factory == nil {
+		return fmt.Errorf("calendar: invalid registration of convention %q", string(convention))
+	}
+
+	registry.Lock()
+	defer registry.Unlock()
+
+	if isTaken(convention) {
+		return fmt.Errorf("%w: %q", ErrAlreadyRegistered, string(convention))
+	}
+
+	registry.factories[convention] = factory
+
+	return nil
+}
+
+// isTaken returns true if the input convention matches a built-in or
+// registered one, case-insensitively as in ParseConvention.
+// The registry must be locked by the caller.
+func isTaken(convention Convention) bool {
+	for _, existing := range conventions {
+		if strings.EqualFold(string(convention), string(existing)) {
+			return true
+		}
+	}
+
+	for existing := range registry.factories {
+		if strings.EqualFold(string(convention), string(existing)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// unregister removes the input convention from the registry, so that
+// tests registering conventions don't leak them into other tests.
+func unregister(convention Convention) {
+	registry.Lock()
+	defer registry.Unlock()
+
+	delete(registry.factories, convention)
+}
+
+// lookup returns the factory registered under the input convention.
+func lookup(convention Convention) (Factory, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	factory, ok := registry.factories[convention]
+
+	return factory, ok
+}
+
+// registered returns the registered conventions, sorted by name.
+func registered() []Convention {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	conventions := make([]Convention, 0, len(registry.factories))
+	for convention := range registry.factories {
+		conventions = append(conventions, convention)
+	}
+
+	sort.Slice(conventions, func(i, j int) bool {
+		return conventions[i] < conventions[j]
+	})
+
+	return conventions
+}
+
+// namedCalendar is a calendar exposed under a registered convention.
+type namedCalendar struct {
+	DayCounter
+	convention Convention
+}
+
+func newNamedCalendar(convention Convention, calendar *Calendar) *namedCalendar {
+	return &namedCalendar{
+		DayCounter: calendar.DayCounter,
+		convention: convention,
+	}
+}
+
+// Convention returns the registered convention.
+func (c namedCalendar) Convention() Convention {
+	return c.convention
+}
+
+// unwrap returns the underlying calendar.
+func (c namedCalendar) unwrap() DayCounter {
+	return c.DayCounter
+}
+
+// describe returns the description of the calendar.
+func (c namedCalendar) describe() (Description, error) {
+	return Description{Convention: c.convention}, nil
+}
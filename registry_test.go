@@ -0,0 +1,117 @@
+package calendar
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/edgelaboratories/date"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// registerTest registers the input convention until the end of the test.
+// Tests registering conventions don't run in parallel, so that the
+// conventions don't leak into the ones iterating over Conventions.
+func registerTest(t *testing.T, convention Convention, factory Factory) {
+	t.Helper()
+
+	require.NoError(t, Register(convention, factory))
+	t.Cleanup(func() {
+		unregister(convention)
+	})
+}
+
+func Test_Register(t *testing.T) {
+	var (
+		convention = Convention("Test_Register")
+		holiday    = date.New(2024, time.April, 10)
+	)
+
+	registerTest(t, convention, func() *Calendar {
+		return NewWithHolidays(holiday)
+	})
+
+	calendar := New(convention)
+	assert.Equal(t, convention, calendar.Convention())
+	assert.False(t, calendar.IsActive(holiday))
+	assert.Equal(t, date.New(2024, time.April, 11), calendar.Next(date.New(2024, time.April, 9)))
+
+	strict, err := NewStrict(convention)
+	require.NoError(t, err)
+	assert.Equal(t, convention, strict.Convention())
+
+	parsed, err := ParseConvention("test_register")
+	require.NoError(t, err)
+	assert.Equal(t, convention, parsed)
+
+	assert.Contains(t, Conventions(), convention)
+
+	description, err := calendar.Describe()
+	require.NoError(t, err)
+	assert.Equal(t, Description{Convention: convention}, description)
+
+	described, err := description.Calendar()
+	require.NoError(t, err)
+	assert.Equal(t, convention, described.Convention())
+	assert.False(t, described.IsActive(holiday))
+}
+
+func Test_Register_Errors(t *testing.T) {
+	factory := func() *Calendar {
+		return New(BusinessDays)
+	}
+
+	registerTest(t, "Test_Register_Errors", factory)
+
+	assert.ErrorIs(t, Register("Test_Register_Errors", factory), ErrAlreadyRegistered)
+	assert.ErrorIs(t, Register(BusinessDays, factory), ErrAlreadyRegistered)
+	assert.ErrorIs(t, Register(NYSE, factory), ErrAlreadyRegistered)
+	assert.ErrorIs(t, Register("test_register_errors", factory), ErrAlreadyRegistered)
+	assert.ErrorIs(t, Register("businessdays", factory), ErrAlreadyRegistered)
+	assert.ErrorIs(t, Register("nyse", factory), ErrAlreadyRegistered)
+	assert.Error(t, Register("", factory))
+	assert.Error(t, Register("Test_Register_Errors/nil", nil))
+}
+
+func Test_Register_Concurrent(t *testing.T) {
+	var wg sync.WaitGroup
+
+	for i := 0; i < 16; i++ {
+		convention := Convention(fmt.Sprintf("Test_Register_Concurrent/%d", i))
+		t.Cleanup(func() {
+			unregister(convention)
+		})
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			assert.NoError(t, Register(convention, func() *Calendar {
+				return New(CalendarDays)
+			}))
+			assert.Equal(t, convention, New(convention).Convention())
+			assert.Contains(t, Conventions(), convention)
+		}()
+	}
+
+	wg.Wait()
+}
+
+func Test_unregister(t *testing.T) {
+	convention := Convention("Test_unregister")
+
+	require.NoError(t, Register(convention, func() *Calendar {
+		return New(BusinessDays)
+	}))
+	unregister(convention)
+
+	assert.False(t, convention.isSupported())
+	assert.NotContains(t, Conventions(), convention)
+	require.NoError(t, Register(convention, func() *Calendar {
+		return New(BusinessDays)
+	}))
+	unregister(convention)
+}
@@ -0,0 +1,173 @@
+package calendar
+
+import (
+	"fmt"
+
+	"github.com/edgelaboratories/date"
+)
+
+// Direction defines the direction in which schedule dates are generated.
+type Direction string
+
+const (
+	// Backward generates dates backward from the end date, so that any
+	// irregular period is at the front of the schedule.
+	Backward Direction = "Backward"
+	// Forward generates dates forward from the start date, so that any
+	// irregular period is at the back of the schedule.
+	Forward Direction = "Forward"
+)
+
+// Stub defines how an irregular period of a schedule is handled.
+type Stub string
+
+const (
+	// ShortStub keeps the irregular period shorter than the tenor.
+	ShortStub Stub = "Short"
+	// LongStub merges the irregular period with the adjacent regular one,
+	// so that it is longer than the tenor.
+	LongStub Stub = "Long"
+)
+
+// ScheduleSpec defines the periodic schedule to generate.
+type ScheduleSpec struct {
+	// Start and End are the first and last dates of the schedule.
+	Start, End date.Date
+	// Tenor is the length of the regular periods.
+	Tenor Period
+	// Direction defines whether the schedule is generated forward from
+	// the start date or backward from the end date (the default), which
+	// respectively leads to a back or a front stub.
+	Direction Direction
+	// Stub defines the length of the irregular period, if any.
+	// Defaults to ShortStub.
+	Stub Stub
+	// Adjustment is the convention used to adjust the schedule dates.
+	Adjustment Adjustment
+	// EndOfMonth enables the end-of-month rule for monthly and yearly tenors:
+	// when the date the schedule is generated from is the last active date
+	// of its month, regular dates are rolled to the end of their month and
+	// adjusted to the last active date of that month.
+	EndOfMonth bool
+}
+
+// Schedule holds the dates of a periodic schedule.
+type Schedule struct {
+	// Unadjusted are the schedule dates before adjustment.
+	Unadjusted []date.Date
+	// Adjusted are the schedule dates adjusted to the calendar.
+	Adjusted []date.Date
+}
+
+// Schedule generates the dates of a periodic schedule, adjusted
+// according to the calendar.
+// An error is returned if the start date is not before the end date,
+// or if the tenor isn't positive.
+func (c *Calendar) Schedule(spec ScheduleSpec) (Schedule, error) {
+	if !spec.Start.Before(spec.End) {
+		return Schedule{}, fmt.Errorf("calendar: schedule start %s is not before end %s", spec.Start, spec.End)
+	}
+
+	if spec.Tenor.Length <= 0 || spec.Tenor.addTo(spec.Start, 1).Equal(spec.Start) {
+		return Schedule{}, fmt.Errorf("calendar: invalid schedule tenor %s", spec.Tenor)
+	}
+
+	var (
+		unadjusted []date.Date
+		endOfMonth bool
+	)
+
+	switch spec.Direction {
+	case Forward:
+		endOfMonth = spec.EndOfMonth && spec.Tenor.isMonthly() && c.IsEndOfMonth(spec.Start)
+		unadjusted = generateForward(spec, endOfMonth, endOfMonth && c.IsEndOfMonth(spec.End))
+
+	case Backward:
+		fallthrough
+
+	default:
+		endOfMonth = spec.EndOfMonth && spec.Tenor.isMonthly() && c.IsEndOfMonth(spec.End)
+		unadjusted = generateBackward(spec, endOfMonth, endOfMonth && c.IsEndOfMonth(spec.Start))
+	}
+
+	adjusted := make([]date.Date, 0, len(unadjusted))
+	for i, d := range unadjusted {
+		if endOfMonth && i > 0 && i < len(unadjusted)-1 {
+			adjusted = append(adjusted, c.EndOfMonth(d))
+		} else {
+			adjusted = append(adjusted, c.Adjust(d, spec.Adjustment))
+		}
+	}
+
+	return Schedule{
+		Unadjusted: unadjusted,
+		Adjusted:   adjusted,
+	}, nil
+}
+
+// rollDate returns the origin date shifted by a multiple of the tenor,
+// moved to the end of its month if the end-of-month rule applies.
+func rollDate(spec ScheduleSpec, origin date.Date, factor int, endOfMonth bool) date.Date {
+	rolled := spec.Tenor.addTo(origin, factor)
+	if endOfMonth {
+		return lastDayOfMonth(rolled.Year(), rolled.Month())
+	}
+
+	return rolled
+}
+
+// reaches returns true if a rolled date coincides with the input bound.
+// When the end-of-month rule applies and the bound is the last active date
+// of its month, rolled dates only have to be in the same month as the bound.
+func reaches(rolled, bound date.Date, boundEndOfMonth bool) bool {
+	if boundEndOfMonth {
+		return rolled.Year() == bound.Year() && rolled.Month() == bound.Month()
+	}
+
+	return rolled.Equal(bound)
+}
+
+// generateForward returns the unadjusted schedule dates, rolling
+// forward from the start date.
+func generateForward(spec ScheduleSpec, endOfMonth, boundEndOfMonth bool) []date.Date {
+	dates := []date.Date{spec.Start}
+
+	current := rollDate(spec, spec.Start, 1, endOfMonth)
+	for i := 2; current.Before(spec.End) && !reaches(current, spec.End, boundEndOfMonth); i++ {
+		dates = append(dates, current)
+		current = rollDate(spec, spec.Start, i, endOfMonth)
+	}
+
+	// Merge the back stub with the last regular period.
+	if !reaches(current, spec.End, boundEndOfMonth) && spec.Stub == LongStub && len(dates) > 1 {
+		dates = dates[:len(dates)-1]
+	}
+
+	return append(dates, spec.End)
+}
+
+// generateBackward returns the unadjusted schedule dates, rolling
+// backward from the end date.
+func generateBackward(spec ScheduleSpec, endOfMonth, boundEndOfMonth bool) []date.Date {
+	dates := []date.Date{spec.End}
+
+	current := rollDate(spec, spec.End, -1, endOfMonth)
+	for i := 2; current.After(spec.Start) && !reaches(current, spec.Start, boundEndOfMonth); i++ {
+		dates = append(dates, current)
+		current = rollDate(spec, spec.End, -i, endOfMonth)
+	}
+
+	// Merge the front stub with the first regular period.
+	if !reaches(current, spec.Start, boundEndOfMonth) && spec.Stub == LongStub && len(dates) > 1 {
+		dates = dates[:len(dates)-1]
+	}
+
+	dates = append(dates, spec.Start)
+
+	// Dates have been generated in reverse order.
+	for i, j := 0, len(dates)-1; i < j; i, j = i+1, j-1 {
+		dates[i], dates[j] = dates[j], dates[i]
+	}
+
+	return dates
+}
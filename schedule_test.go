@@ -0,0 +1,245 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgelaboratories/date"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Calendar_Schedule(t *testing.T) {
+	t.Parallel()
+
+	var (
+		start     = date.New(2021, time.January, 15)
+		end       = date.New(2021, time.December, 15)
+		quarterly = Period{3, PeriodMonths}
+	)
+
+	for _, tc := range []struct {
+		name     string
+		spec     ScheduleSpec
+		expected []date.Date
+	}{
+		{
+			"regular",
+			ScheduleSpec{
+				Start: start,
+				End:   date.New(2022, time.January, 15),
+				Tenor: quarterly,
+			},
+			[]date.Date{
+				start,
+				date.New(2021, time.April, 15),
+				date.New(2021, time.July, 15),
+				date.New(2021, time.October, 15),
+				date.New(2022, time.January, 15),
+			},
+		},
+		{
+			"backward/short front stub",
+			ScheduleSpec{
+				Start:     start,
+				End:       end,
+				Tenor:     quarterly,
+				Direction: Backward,
+				Stub:      ShortStub,
+			},
+			[]date.Date{
+				start,
+				date.New(2021, time.March, 15),
+				date.New(2021, time.June, 15),
+				date.New(2021, time.September, 15),
+				end,
+			},
+		},
+		{
+			"backward/long front stub",
+			ScheduleSpec{
+				Start:     start,
+				End:       end,
+				Tenor:     quarterly,
+				Direction: Backward,
+				Stub:      LongStub,
+			},
+			[]date.Date{
+				start,
+				date.New(2021, time.June, 15),
+				date.New(2021, time.September, 15),
+				end,
+			},
+		},
+		{
+			"forward/short back stub",
+			ScheduleSpec{
+				Start:     start,
+				End:       end,
+				Tenor:     quarterly,
+				Direction: Forward,
+				Stub:      ShortStub,
+			},
+			[]date.Date{
+				start,
+				date.New(2021, time.April, 15),
+				date.New(2021, time.July, 15),
+				date.New(2021, time.October, 15),
+				end,
+			},
+		},
+		{
+			"forward/long back stub",
+			ScheduleSpec{
+				Start:     start,
+				End:       end,
+				Tenor:     quarterly,
+				Direction: Forward,
+				Stub:      LongStub,
+			},
+			[]date.Date{
+				start,
+				date.New(2021, time.April, 15),
+				date.New(2021, time.July, 15),
+				end,
+			},
+		},
+		{
+			"long stub/single period",
+			ScheduleSpec{
+				Start: start,
+				End:   date.New(2021, time.March, 1),
+				Tenor: quarterly,
+				Stub:  LongStub,
+			},
+			[]date.Date{
+				start,
+				date.New(2021, time.March, 1),
+			},
+		},
+		{
+			"month end",
+			ScheduleSpec{
+				Start:     date.New(2021, time.January, 31),
+				End:       date.New(2021, time.May, 31),
+				Tenor:     Period{1, PeriodMonths},
+				Direction: Forward,
+			},
+			[]date.Date{
+				date.New(2021, time.January, 31),
+				date.New(2021, time.February, 28),
+				date.New(2021, time.March, 31),
+				date.New(2021, time.April, 30),
+				date.New(2021, time.May, 31),
+			},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			schedule, err := New(CalendarDays).Schedule(tc.spec)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expected, schedule.Unadjusted)
+			assert.Equal(t, tc.expected, schedule.Adjusted)
+		})
+	}
+}
+
+func Test_Calendar_Schedule_Adjusted(t *testing.T) {
+	t.Parallel()
+
+	schedule, err := New(BusinessDays).Schedule(ScheduleSpec{
+		Start:      date.New(2021, time.January, 31),
+		End:        date.New(2021, time.July, 31),
+		Tenor:      Period{2, PeriodMonths},
+		Adjustment: ModifiedFollowing,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []date.Date{
+		date.New(2021, time.January, 31),
+		date.New(2021, time.March, 31),
+		date.New(2021, time.May, 31),
+		date.New(2021, time.July, 31),
+	}, schedule.Unadjusted)
+
+	assert.Equal(t, []date.Date{
+		date.New(2021, time.January, 29),
+		date.New(2021, time.March, 31),
+		date.New(2021, time.May, 31),
+		date.New(2021, time.July, 30),
+	}, schedule.Adjusted)
+}
+
+func Test_Calendar_Schedule_Errors(t *testing.T) {
+	t.Parallel()
+
+	var (
+		calendar = New(BusinessDays)
+		start    = date.New(2021, time.January, 15)
+		end      = date.New(2021, time.December, 15)
+	)
+
+	_, err := calendar.Schedule(ScheduleSpec{Start: end, End: start, Tenor: Period{1, PeriodMonths}})
+	assert.Error(t, err)
+
+	_, err = calendar.Schedule(ScheduleSpec{Start: start, End: start, Tenor: Period{1, PeriodMonths}})
+	assert.Error(t, err)
+
+	_, err = calendar.Schedule(ScheduleSpec{Start: start, End: end, Tenor: Period{0, PeriodMonths}})
+	assert.Error(t, err)
+
+	_, err = calendar.Schedule(ScheduleSpec{Start: start, End: end, Tenor: Period{1, PeriodUnit("X")}})
+	assert.Error(t, err)
+}
+
+func Test_Calendar_Schedule_EndOfMonth(t *testing.T) {
+	t.Parallel()
+
+	var (
+		calendar = New(BusinessDays)
+		spec     = ScheduleSpec{
+			// Last business days of January and July 2021.
+			Start:      date.New(2021, time.January, 29),
+			End:        date.New(2021, time.July, 30),
+			Tenor:      Period{2, PeriodMonths},
+			Adjustment: Following,
+		}
+	)
+
+	schedule, err := calendar.Schedule(spec)
+	require.NoError(t, err)
+
+	assert.Equal(t, []date.Date{
+		date.New(2021, time.January, 29),
+		date.New(2021, time.January, 30),
+		date.New(2021, time.March, 30),
+		date.New(2021, time.May, 30),
+		date.New(2021, time.July, 30),
+	}, schedule.Unadjusted)
+
+	for _, direction := range []Direction{Backward, Forward} {
+		spec.EndOfMonth = true
+		spec.Direction = direction
+
+		schedule, err = calendar.Schedule(spec)
+		require.NoError(t, err)
+
+		assert.Equal(t, []date.Date{
+			date.New(2021, time.January, 29),
+			date.New(2021, time.March, 31),
+			date.New(2021, time.May, 31),
+			date.New(2021, time.July, 30),
+		}, schedule.Unadjusted)
+
+		assert.Equal(t, []date.Date{
+			date.New(2021, time.January, 29),
+			date.New(2021, time.March, 31),
+			date.New(2021, time.May, 31),
+			date.New(2021, time.July, 30),
+		}, schedule.Adjusted)
+	}
+}
@@ -0,0 +1,162 @@
+package calendar
+
+import (
+	"sort"
+
+	"github.com/edgelaboratories/date"
+)
+
+// Session is the trading session of a date.
+type Session string
+
+const (
+	// FullDay is a regular session.
+	FullDay Session = "FullDay"
+	// HalfDay is a shortened session, such as an early close.
+	HalfDay Session = "HalfDay"
+	// Closed is the session of inactive dates.
+	Closed Session = "Closed"
+)
+
+// halfDayWeight is the weight of half-day sessions.
+const halfDayWeight = 0.5
+
+// Weight returns the weight of the session: 1 for full days,
+// 0.5 for half days and 0 for closed dates.
+func (s Session) Weight() float64 {
+	switch s {
+	case FullDay:
+		return 1
+	case HalfDay:
+		return halfDayWeight
+	case Closed:
+		fallthrough
+
+	default:
+		return 0
+	}
+}
+
+// halfDayer is implemented by calendars with half days.
+type halfDayer interface {
+	// halfDays returns the sorted half days, which may include
+	// inactive dates.
+	halfDays() []date.Date
+}
+
+// halfDays returns the sorted half days of the input calendar, if any.
+func halfDays(counter DayCounter) []date.Date {
+	if h, ok := find[halfDayer](counter); ok {
+		return h.halfDays()
+	}
+
+	return nil
+}
+
+// halfDayCalendar is a calendar whose active days are the ones of
+// a base calendar, with additional half days.
+type halfDayCalendar struct {
+	DayCounter
+	// all is the sorted list of the half days, including the ones
+	// of the base calendar.
+	all []date.Date
+	// added is the sorted list of the half days added on top of the
+	// base calendar, used to describe the calendar.
+	added []date.Date
+}
+
+func newHalfDayCalendar(base DayCounter, added []date.Date) *halfDayCalendar {
+	added = sortDates(append([]date.Date{}, added...))
+
+	return &halfDayCalendar{
+		DayCounter: base,
+		all:        sortDates(append(append([]date.Date{}, halfDays(base)...), added...)),
+		added:      added,
+	}
+}
+
+// NewWithHalfDays returns a calendar with the same active days as the
+// input calendar, in which the input dates are half days when active,
+// on top of the half days of the input calendar.
+func NewWithHalfDays(calendar *Calendar, halfDays ...date.Date) *Calendar {
+	return &Calendar{newHalfDayCalendar(calendar.DayCounter, halfDays)}
+}
+
+// halfDays returns the sorted half days of the calendar.
+func (c halfDayCalendar) halfDays() []date.Date {
+	return c.all
+}
+
+// unwrap returns the base calendar.
+func (c halfDayCalendar) unwrap() DayCounter {
+	return c.DayCounter
+}
+
+// describe returns the description of the base calendar, along with
+// the added half days.
+func (c halfDayCalendar) describe() (Description, error) {
+	description, err := describe(c.DayCounter)
+	if err != nil {
+		return Description{}, err
+	}
+
+	if len(c.added) != 0 {
+		description.HalfDays = sortDates(append(append([]date.Date{}, description.HalfDays...), c.added...))
+	}
+
+	return description, nil
+}
+
+// Session returns the session of the input date: Closed if it is not
+// active, HalfDay if it is an active half day, and FullDay otherwise.
+func (c *Calendar) Session(d date.Date) Session {
+	if !c.IsActive(d) {
+		return Closed
+	}
+
+	if containsDate(halfDays(c.DayCounter), d) {
+		return HalfDay
+	}
+
+	return FullDay
+}
+
+// Weight returns the weight of the session of the input date.
+func (c *Calendar) Weight(d date.Date) float64 {
+	return c.Session(d).Weight()
+}
+
+// WeightedDaysBetween computes the sum of the weights of the dates
+// between from (excluded) and to (included), that is the number of
+// active days where half days only count for a half.
+func (c *Calendar) WeightedDaysBetween(from, to date.Date) float64 {
+	if from.After(to) {
+		return -c.WeightedDaysBetween(to, from)
+	}
+
+	days := float64(c.DaysBetween(from, to))
+
+	halfDays := halfDays(c.DayCounter)
+	for i := countDatesUntil(halfDays, from); i < len(halfDays) && !halfDays[i].After(to); i++ {
+		if c.IsActive(halfDays[i]) {
+			days -= 1 - halfDayWeight
+		}
+	}
+
+	return days
+}
+
+// countDatesUntil returns the number of input sorted dates before
+// or equal to the input date.
+func countDatesUntil(dates []date.Date, d date.Date) int {
+	return sort.Search(len(dates), func(i int) bool {
+		return dates[i].After(d)
+	})
+}
+
+// containsDate returns true if the input sorted dates contain the input date.
+func containsDate(dates []date.Date, d date.Date) bool {
+	i := countDatesUntil(dates, d)
+
+	return i > 0 && dates[i-1].Equal(d)
+}
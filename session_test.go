@@ -0,0 +1,137 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgelaboratories/date"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Session_Weight(t *testing.T) {
+	t.Parallel()
+
+	assert.InDelta(t, 1.0, FullDay.Weight(), 0)
+	assert.InDelta(t, 0.5, HalfDay.Weight(), 0)
+	assert.InDelta(t, 0.0, Closed.Weight(), 0)
+	assert.InDelta(t, 0.0, Session("Unknown").Weight(), 0)
+}
+
+func Test_Calendar_Session(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name       string
+		convention Convention
+		date       date.Date
+		expected   Session
+	}{
+		{"business days", BusinessDays, date.New(2021, time.December, 24), FullDay},
+		{"weekend", BusinessDays, date.New(2021, time.December, 25), Closed},
+		{"NYSE/independence day eve", NYSE, date.New(2019, time.July, 3), HalfDay},
+		{"NYSE/observed independence day", NYSE, date.New(2020, time.July, 3), Closed},
+		{"NYSE/day after thanksgiving", NYSE, date.New(2021, time.November, 26), HalfDay},
+		{"NYSE/christmas eve", NYSE, date.New(2019, time.December, 24), HalfDay},
+		{"NYSE/observed christmas", NYSE, date.New(2021, time.December, 24), Closed},
+		{"NYSE/regular day", NYSE, date.New(2021, time.November, 29), FullDay},
+		{"LSE/new year's eve", LSE, date.New(2021, time.December, 31), HalfDay},
+		{"EuronextParis/christmas eve", EuronextParis, date.New(2020, time.December, 24), HalfDay},
+		{"TARGET2/christmas eve", TARGET2, date.New(2020, time.December, 24), FullDay},
+	} {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			calendar := New(tc.convention)
+
+			assert.Equal(t, tc.expected, calendar.Session(tc.date))
+			assert.InDelta(t, tc.expected.Weight(), calendar.Weight(tc.date), 0)
+		})
+	}
+}
+
+func Test_Calendar_WeightedDaysBetween(t *testing.T) {
+	t.Parallel()
+
+	var (
+		calendar = New(NYSE)
+		from     = date.New(2021, time.November, 22)
+		to       = date.New(2021, time.November, 29)
+	)
+
+	// Thanksgiving is a holiday and the day after is a half day.
+	assert.Equal(t, 4, calendar.DaysBetween(from, to))
+	assert.InDelta(t, 3.5, calendar.WeightedDaysBetween(from, to), 1e-12)
+	assert.InDelta(t, -3.5, calendar.WeightedDaysBetween(to, from), 1e-12)
+
+	// The bounds are handled like DaysBetween.
+	assert.InDelta(t, 0.5, calendar.WeightedDaysBetween(from.Add(3), from.Add(4)), 1e-12)
+	assert.InDelta(t, 0.0, calendar.WeightedDaysBetween(from.Add(4), from.Add(5)), 1e-12)
+
+	assert.InDelta(t, 5.0, New(BusinessDays).WeightedDaysBetween(from, to), 1e-12)
+}
+
+func Test_NewWithHalfDays(t *testing.T) {
+	t.Parallel()
+
+	var (
+		saturday = date.New(2021, time.December, 4)
+		tuesday  = date.New(2021, time.December, 7)
+		calendar = NewWithHalfDays(New(NYSE), tuesday, saturday)
+	)
+
+	assert.Equal(t, NYSE, calendar.Convention())
+	assert.Equal(t, HalfDay, calendar.Session(tuesday))
+	assert.Equal(t, Closed, calendar.Session(saturday))
+	assert.Equal(t, HalfDay, calendar.Session(date.New(2021, time.November, 26)))
+
+	description, err := calendar.Describe()
+	require.NoError(t, err)
+	assert.Equal(t, Description{Convention: NYSE, HalfDays: []date.Date{saturday, tuesday}}, description)
+
+	described, err := description.Calendar()
+	require.NoError(t, err)
+	assert.Equal(t, HalfDay, described.Session(tuesday))
+	assert.Equal(t, HalfDay, described.Session(date.New(2021, time.November, 26)))
+
+	// Half days are kept by the other calendar wrappers.
+	assert.Equal(t, HalfDay, NewIndexed(calendar, tuesday, tuesday).Session(tuesday))
+
+	holidays, err := Description{Convention: NYSE, Holidays: []date.Date{tuesday}}.Calendar()
+	require.NoError(t, err)
+	assert.Equal(t, Closed, holidays.Session(tuesday))
+	assert.Equal(t, HalfDay, holidays.Session(date.New(2021, time.November, 26)))
+}
+
+func Test_NewJoint_Sessions(t *testing.T) {
+	t.Parallel()
+
+	var (
+		nyse = New(NYSE)
+		lse  = New(LSE)
+	)
+
+	for _, tc := range []struct {
+		name     string
+		rule     JointRule
+		date     date.Date
+		expected Session
+	}{
+		{"all/half day in one calendar", AllActive, date.New(2019, time.July, 3), HalfDay},
+		{"all/half day in both calendars", AllActive, date.New(2019, time.December, 24), HalfDay},
+		{"all/closed in one calendar", AllActive, date.New(2021, time.December, 24), Closed},
+		{"any/half day in one calendar", AnyActive, date.New(2019, time.July, 3), FullDay},
+		{"any/half day in both calendars", AnyActive, date.New(2019, time.December, 24), HalfDay},
+		{"any/half day in the only open calendar", AnyActive, date.New(2021, time.December, 24), HalfDay},
+	} {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, newTestJoint(t, tc.rule, nyse, lse).Session(tc.date))
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package calendar
+
+import "github.com/edgelaboratories/date"
+
+// SettlementDate returns the date lag active days after the trade date,
+// where active days are the ones active in all the input calendars.
+// Trade dates which are not active are first rolled to the next active date.
+// The calendars must have active days in common.
+func SettlementDate(trade date.Date, lag int, calendar *Calendar, others ...*Calendar) date.Date {
+	joint := allActive{calendar, others}
+
+	return joint.add(joint.roll(trade, 1), lag)
+}
+
+// FXSpotDate returns the spot date of a currency pair traded on the
+// trade date, according to the market-standard rules:
+//   - the lag, usually 2 or 1 for USD/CAD, is counted in days active in
+//     the calendars of the non-USD currencies, so that USD holidays don't
+//     count against intermediate days;
+//   - the resulting date is rolled forward to the first date active in
+//     all the calendars, USD included.
+//
+// Pairs against USD only have one non-USD calendar, while crosses have two.
+// Trade dates which are not active are first rolled to the next active date.
+// The calendars must have active days in common.
+func FXSpotDate(trade date.Date, lag int, usd *Calendar, currencies ...*Calendar) date.Date {
+	counting := allActive{usd, nil}
+	if len(currencies) != 0 {
+		counting = allActive{currencies[0], currencies[1:]}
+	}
+
+	spot := counting.add(counting.roll(trade, 1), lag)
+
+	return allActive{usd, currencies}.roll(spot, 1)
+}
+
+// allActive considers a date active if it is active in all the calendars.
+// As opposed to joint calendars, it is built without allocating, so that
+// settlement dates are computed without allocating either.
+type allActive struct {
+	calendar *Calendar
+	others   []*Calendar
+}
+
+// isActive returns true if the input date is active in all the calendars.
+func (c allActive) isActive(d date.Date) bool {
+	if !c.calendar.IsActive(d) {
+		return false
+	}
+
+	for _, other := range c.others {
+		if !other.IsActive(d) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// roll returns the first date active in all the calendars from the input
+// date (included), going forward for a positive step and backward otherwise.
+func (c allActive) roll(d date.Date, step int) date.Date {
+	adjustment := Following
+	if step < 0 {
+		adjustment = Preceding
+	}
+
+	for !c.isActive(d) {
+		d = c.calendar.Adjust(d, adjustment)
+		for _, other := range c.others {
+			d = other.Adjust(d, adjustment)
+		}
+	}
+
+	return d
+}
+
+// add adds an input number of days active in all the calendars to the
+// input origin date, assumed to be active in all of them.
+// The days parameter is allowed to be negative.
+func (c allActive) add(origin date.Date, days int) date.Date {
+	step := 1
+	if days < 0 {
+		step, days = -1, -days
+	}
+
+	current := origin
+	for ; days > 0; days-- {
+		current = c.roll(current.Add(step), step)
+	}
+
+	return current
+}
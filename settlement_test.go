@@ -0,0 +1,196 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgelaboratories/date"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_SettlementDate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		nyse = New(NYSE)
+		lse  = New(LSE)
+	)
+
+	for _, tc := range []struct {
+		name      string
+		trade     date.Date
+		lag       int
+		calendars []*Calendar
+		expected  date.Date
+	}{
+		{
+			"T+2",
+			date.New(2021, time.November, 22),
+			2,
+			[]*Calendar{nyse},
+			date.New(2021, time.November, 24),
+		},
+		{
+			"T+2 over a holiday",
+			date.New(2021, time.November, 24),
+			2,
+			[]*Calendar{nyse},
+			date.New(2021, time.November, 29),
+		},
+		{
+			"T+0",
+			date.New(2021, time.November, 24),
+			0,
+			[]*Calendar{nyse},
+			date.New(2021, time.November, 24),
+		},
+		{
+			"negative lag over a holiday",
+			date.New(2021, time.November, 29),
+			-2,
+			[]*Calendar{nyse, lse},
+			date.New(2021, time.November, 24),
+		},
+		{
+			"trade on a weekend",
+			date.New(2021, time.November, 27),
+			1,
+			[]*Calendar{nyse},
+			date.New(2021, time.November, 30),
+		},
+		{
+			"joint calendars",
+			date.New(2021, time.May, 28),
+			2,
+			[]*Calendar{nyse, lse},
+			// Memorial Day and Spring bank holiday on the 31st,
+			// then the 1st and the 2nd are active in both.
+			date.New(2021, time.June, 2),
+		},
+		{
+			"holidays in different calendars",
+			date.New(2021, time.August, 27),
+			2,
+			[]*Calendar{nyse, lse},
+			// Summer bank holiday on the 30th, Labor Day on the 6th.
+			date.New(2021, time.September, 1),
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, SettlementDate(tc.trade, tc.lag, tc.calendars[0], tc.calendars[1:]...))
+		})
+	}
+}
+
+func Test_FXSpotDate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		usd = New(NYSE)
+		eur = New(TARGET2)
+		gbp = New(LSE)
+	)
+
+	for _, tc := range []struct {
+		name       string
+		trade      date.Date
+		lag        int
+		currencies []*Calendar
+		expected   date.Date
+	}{
+		{
+			"USD holiday on the intermediate day",
+			// Independence Day on Friday 4th only matters for the spot date.
+			date.New(2025, time.July, 3),
+			2,
+			[]*Calendar{eur},
+			date.New(2025, time.July, 7),
+		},
+		{
+			"USD holiday on the spot date",
+			date.New(2025, time.July, 2),
+			2,
+			[]*Calendar{eur},
+			date.New(2025, time.July, 7),
+		},
+		{
+			"non-USD holiday on the intermediate day",
+			// Summer bank holiday on Monday 30th.
+			date.New(2021, time.August, 27),
+			2,
+			[]*Calendar{gbp},
+			date.New(2021, time.September, 1),
+		},
+		{
+			"T+1",
+			date.New(2021, time.August, 26),
+			1,
+			[]*Calendar{gbp},
+			date.New(2021, time.August, 27),
+		},
+		{
+			"cross with a USD holiday on the spot date",
+			// Labor Day on Monday 6th.
+			date.New(2021, time.September, 2),
+			2,
+			[]*Calendar{eur, gbp},
+			date.New(2021, time.September, 7),
+		},
+		{
+			"cross with a holiday on the intermediate day",
+			// Good Friday on the 2nd and Easter Monday on the 5th.
+			date.New(2021, time.April, 1),
+			2,
+			[]*Calendar{eur, gbp},
+			date.New(2021, time.April, 7),
+		},
+		{
+			"USD only",
+			date.New(2021, time.November, 24),
+			2,
+			nil,
+			date.New(2021, time.November, 29),
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, FXSpotDate(tc.trade, tc.lag, usd, tc.currencies...))
+		})
+	}
+}
+
+func Benchmark_SettlementDate(b *testing.B) {
+	var (
+		nyse = New(NYSE)
+		lse  = New(LSE)
+	)
+
+	b.ReportAllocs()
+	b.RunParallel(func(p *testing.PB) {
+		for p.Next() {
+			_ = SettlementDate(benchmarkOrigin, 2, nyse, lse)
+		}
+	})
+}
+
+func Benchmark_FXSpotDate(b *testing.B) {
+	var (
+		usd = New(NYSE)
+		eur = New(TARGET2)
+		gbp = New(LSE)
+	)
+
+	b.ReportAllocs()
+	b.RunParallel(func(p *testing.PB) {
+		for p.Next() {
+			_ = FXSpotDate(benchmarkOrigin, 2, usd, eur, gbp)
+		}
+	})
+}
@@ -0,0 +1,170 @@
+package calendar
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/edgelaboratories/date"
+)
+
+// daysInWeek is the number of days in a week.
+const daysInWeek = 7
+
+// ErrEmptyWeek is returned when every day of the week is a weekend day.
+var ErrEmptyWeek = errors.New("calendar: every day of the week is a weekend day")
+
+// weekendCalendar is a calendar whose active days are working ones,
+// with an arbitrary set of weekend days.
+// No further holidays are taken into account.
+//
+// Shifts and counts are computed in constant time by means of tables
+// indexed by weekday, precomputed once for all.
+type weekendCalendar struct {
+	weekend [daysInWeek]bool
+	// activeDays is the number of active days in a week.
+	activeDays int
+	// latest gives, per weekday, the number of days to go back
+	// to reach the latest active day.
+	latest [daysInWeek]int
+	// counts gives, per weekday of a date d and per number of days k,
+	// the number of active days between d (excluded) and d+k (included).
+	counts [daysInWeek][daysInWeek + 1]int
+	// forward gives, per weekday of a date d and per number of active
+	// days n, the number of days to go forward from d to reach the
+	// nth active day after d.
+	forward [daysInWeek][daysInWeek + 1]int
+	// backward gives, per weekday of a date d and per number of active
+	// days n, the number of days to go backward from d to reach the
+	// nth active day before d.
+	backward [daysInWeek][daysInWeek + 1]int
+}
+
+func newWeekendCalendar(weekend []time.Weekday) (*weekendCalendar, error) {
+	c := &weekendCalendar{}
+
+	for _, w := range weekend {
+		if w < time.Sunday || w > time.Saturday {
+			return nil, fmt.Errorf("calendar: invalid weekday %d", w)
+		}
+
+		c.weekend[w] = true
+	}
+
+	for _, isWeekend := range c.weekend {
+		if !isWeekend {
+			c.activeDays++
+		}
+	}
+
+	if c.activeDays == 0 {
+		return nil, ErrEmptyWeek
+	}
+
+	for w := 0; w < daysInWeek; w++ {
+		for c.weekend[(w-c.latest[w]+daysInWeek)%daysInWeek] {
+			c.latest[w]++
+		}
+
+		forwardCount, backwardCount := 0, 0
+
+		for k := 1; k <= daysInWeek; k++ {
+			if !c.weekend[(w+k)%daysInWeek] {
+				forwardCount++
+				if c.forward[w][forwardCount] == 0 {
+					c.forward[w][forwardCount] = k
+				}
+			}
+
+			if !c.weekend[(w-k+daysInWeek)%daysInWeek] {
+				backwardCount++
+				if c.backward[w][backwardCount] == 0 {
+					c.backward[w][backwardCount] = k
+				}
+			}
+
+			c.counts[w][k] = forwardCount
+		}
+	}
+
+	return c, nil
+}
+
+// Convention returns the BusinessDays convention.
+func (c weekendCalendar) Convention() Convention {
+	return BusinessDays
+}
+
+// IsActive returns true if the input date is not a weekend day.
+func (c weekendCalendar) IsActive(date date.Date) bool {
+	return !c.isWeekend(date)
+}
+
+// DaysInYear returns the standard year duration according to the
+// business-days calendar, scaled to the number of active days in
+// a week when it differs from five.
+func (c weekendCalendar) DaysInYear() int {
+	return 252 * c.activeDays / 5
+}
+
+// Add adds an input number of active days to the input origin date.
+// The days parameter is allowed to be negative.
+// This method is idempotent when a zero-days shift is requested.
+func (c weekendCalendar) Add(origin date.Date, days int) date.Date {
+	current := origin.Add(-c.latest[origin.Weekday()])
+
+	switch {
+	case days > 0:
+		// Shift by whole weeks, keeping between 1 and activeDays days left.
+		weeks := (days - 1) / c.activeDays
+		left := days - weeks*c.activeDays
+
+		return current.Add(weeks*daysInWeek + c.forward[current.Weekday()][left])
+
+	case days < 0:
+		weeks := (-days - 1) / c.activeDays
+		left := -days - weeks*c.activeDays
+
+		return current.Add(-weeks*daysInWeek - c.backward[current.Weekday()][left])
+
+	default:
+		return current
+	}
+}
+
+// DaysBetween computes the number of active dates between
+// from (excluded) and to (included).
+func (c weekendCalendar) DaysBetween(from, to date.Date) int {
+	if from.After(to) {
+		return -c.DaysBetween(to, from)
+	}
+
+	days := to.Sub(from)
+
+	return days/daysInWeek*c.activeDays + c.counts[from.Weekday()][days%daysInWeek]
+}
+
+// isWeekend returns true if the input date is a weekend day.
+func (c weekendCalendar) isWeekend(date date.Date) bool {
+	return c.weekend[date.Weekday()]
+}
+
+// describe returns the description of the calendar.
+func (c weekendCalendar) describe() (Description, error) {
+	weekend := []Weekday{}
+
+	for w, isWeekend := range c.weekend {
+		if isWeekend {
+			weekend = append(weekend, Weekday(w))
+		}
+	}
+
+	if len(weekend) == 0 {
+		return Description{Convention: BusinessDays, NoWeekend: true}, nil
+	}
+
+	return Description{
+		Convention: BusinessDays,
+		Weekend:    weekend,
+	}, nil
+}
@@ -0,0 +1,240 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgelaboratories/date"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_newWeekendCalendar(t *testing.T) {
+	t.Parallel()
+
+	calendar, err := newWeekendCalendar([]time.Weekday{time.Friday, time.Saturday})
+	require.NoError(t, err)
+
+	assert.Equal(t, 5, calendar.activeDays)
+	assert.Equal(t, BusinessDays, calendar.Convention())
+	assert.Equal(t, 252, calendar.DaysInYear())
+
+	_, err = newWeekendCalendar([]time.Weekday{
+		time.Sunday,
+		time.Monday,
+		time.Tuesday,
+		time.Wednesday,
+		time.Thursday,
+		time.Friday,
+		time.Saturday,
+	})
+	assert.ErrorIs(t, err, ErrEmptyWeek)
+
+	_, err = newWeekendCalendar([]time.Weekday{time.Weekday(7)})
+	assert.Error(t, err)
+}
+
+func Test_weekendCalendar_IsActive(t *testing.T) {
+	t.Parallel()
+
+	calendar, err := newWeekendCalendar([]time.Weekday{time.Friday, time.Saturday})
+	require.NoError(t, err)
+
+	// Dates range from Thursday to Sunday.
+	assert.True(t, calendar.IsActive(date.New(2021, time.September, 23)))
+	assert.False(t, calendar.IsActive(date.New(2021, time.September, 24)))
+	assert.False(t, calendar.IsActive(date.New(2021, time.September, 25)))
+	assert.True(t, calendar.IsActive(date.New(2021, time.September, 26)))
+}
+
+func Test_weekendCalendar_Add(t *testing.T) {
+	t.Parallel()
+
+	calendar, err := newWeekendCalendar([]time.Weekday{time.Friday, time.Saturday})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		origin   date.Date
+		days     int
+		expected date.Date
+	}{
+		{
+			// Saturday to Thursday.
+			date.New(2021, time.September, 25),
+			0,
+			date.New(2021, time.September, 23),
+		},
+		{
+			// Thursday to Sunday.
+			date.New(2021, time.September, 23),
+			1,
+			date.New(2021, time.September, 26),
+		},
+		{
+			// Sunday to Thursday.
+			date.New(2021, time.September, 26),
+			-1,
+			date.New(2021, time.September, 23),
+		},
+		{
+			// Friday to Sunday.
+			date.New(2021, time.September, 24),
+			1,
+			date.New(2021, time.September, 26),
+		},
+		{
+			// Thursday to next Thursday.
+			date.New(2021, time.September, 23),
+			5,
+			date.New(2021, time.September, 30),
+		},
+	} {
+		assert.Equal(t, tc.expected, calendar.Add(tc.origin, tc.days))
+	}
+}
+
+func Test_weekendCalendar_DaysBetween(t *testing.T) {
+	t.Parallel()
+
+	calendar, err := newWeekendCalendar([]time.Weekday{time.Friday, time.Saturday})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		from     date.Date
+		to       date.Date
+		expected int
+	}{
+		{
+			date.New(2021, time.September, 23),
+			date.New(2021, time.September, 25),
+			0,
+		},
+		{
+			date.New(2021, time.September, 23),
+			date.New(2021, time.September, 26),
+			1,
+		},
+		{
+			date.New(2021, time.September, 24),
+			date.New(2021, time.September, 26),
+			1,
+		},
+		{
+			date.New(2021, time.September, 23),
+			date.New(2021, time.September, 30),
+			5,
+		},
+	} {
+		assert.Equal(t, tc.expected, calendar.DaysBetween(tc.from, tc.to))
+		assert.Equal(t, -tc.expected, calendar.DaysBetween(tc.to, tc.from))
+	}
+}
+
+func Test_weekendCalendar_ConsistencyChecks(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name    string
+		weekend []time.Weekday
+	}{
+		{"none", nil},
+		{"sunday", []time.Weekday{time.Sunday}},
+		{"saturday and sunday", []time.Weekday{time.Saturday, time.Sunday}},
+		{"thursday and friday", []time.Weekday{time.Thursday, time.Friday}},
+		{"friday and saturday", []time.Weekday{time.Friday, time.Saturday}},
+		{"split", []time.Weekday{time.Monday, time.Wednesday, time.Friday}},
+		{"all but tuesday", []time.Weekday{
+			time.Sunday,
+			time.Monday,
+			time.Wednesday,
+			time.Thursday,
+			time.Friday,
+			time.Saturday,
+		}},
+	} {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			calendar, err := newWeekendCalendar(tc.weekend)
+			require.NoError(t, err)
+
+			origin := date.New(2021, time.January, 4)
+
+			// Compare to a day-by-day count of active days.
+			for j := 0; j < 7; j++ {
+				from := origin.Add(j)
+				count := 0
+
+				for current := from.Add(1); current.Before(from.Add(60)); current = current.Add(1) {
+					if calendar.IsActive(current) {
+						count++
+					}
+
+					assert.Equal(t, count, calendar.DaysBetween(from, current))
+				}
+			}
+
+			for j := 0; j < 7; j++ {
+				current := origin.Add(j)
+
+				for i := 1; i <= 64; i++ {
+					to := calendar.Add(current, i)
+					from := calendar.Add(to, -i)
+
+					assert.True(t, calendar.IsActive(to))
+					assert.True(t, calendar.IsActive(from))
+					assert.Equal(t, i, calendar.DaysBetween(current, to))
+					assert.Equal(t, i, calendar.DaysBetween(from, to))
+					assert.True(t, calendar.Add(from, i).Equal(to))
+					assert.True(t, calendar.Add(current, 0).Equal(calendar.Add(to, -i)))
+				}
+			}
+		})
+	}
+}
+
+func Test_weekendCalendar_MatchesBusinessCalendar(t *testing.T) {
+	t.Parallel()
+
+	var (
+		business   = newBusinessCalendar()
+		origin     = date.New(2021, time.January, 4)
+		weekend, _ = newWeekendCalendar([]time.Weekday{time.Saturday, time.Sunday})
+	)
+
+	for j := 0; j < 7; j++ {
+		current := origin.Add(j)
+
+		for i := -64; i <= 64; i++ {
+			assert.Equal(t, business.Add(current, i), weekend.Add(current, i))
+			assert.Equal(t, business.DaysBetween(current, current.Add(i)), weekend.DaysBetween(current, current.Add(i)))
+		}
+	}
+}
+
+func Test_NewWithWeekend(t *testing.T) {
+	t.Parallel()
+
+	calendar, err := NewWithWeekend(
+		[]time.Weekday{time.Friday, time.Saturday},
+		date.New(2021, time.September, 26),
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, BusinessDays, calendar.Convention())
+	assert.Equal(t, date.New(2021, time.September, 27), calendar.Next(date.New(2021, time.September, 23)))
+	assert.Equal(t, 1, calendar.DaysBetween(date.New(2021, time.September, 23), date.New(2021, time.September, 27)))
+
+	_, err = NewWithWeekend([]time.Weekday{
+		time.Sunday,
+		time.Monday,
+		time.Tuesday,
+		time.Wednesday,
+		time.Thursday,
+		time.Friday,
+		time.Saturday,
+	})
+	assert.ErrorIs(t, err, ErrEmptyWeek)
+}
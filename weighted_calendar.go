@@ -0,0 +1,190 @@
+package calendar
+
+import (
+	"sync"
+	"time"
+
+	"github.com/edgelaboratories/date"
+)
+
+// WeightRule assigns weights to dates, such as the weekend days.
+type WeightRule interface {
+	// Weight returns the weight of the input date with respect to
+	// the input calendar, and whether the rule applies to the date.
+	Weight(calendar *Calendar, d date.Date) (float64, bool)
+}
+
+// WeekendWeight is the weight of the weekend days.
+type WeekendWeight struct {
+	Value float64
+}
+
+// Weight returns the weight of the input date if it is a weekend day.
+func (r WeekendWeight) Weight(calendar *Calendar, d date.Date) (float64, bool) {
+	return r.Value, !calendar.IsActive(d) && isWeekend(calendar.DayCounter, d)
+}
+
+// HolidayWeight is the weight of the holidays, i.e. the inactive dates
+// which are not weekend days.
+type HolidayWeight struct {
+	Value float64
+}
+
+// Weight returns the weight of the input date if it is a holiday.
+func (r HolidayWeight) Weight(calendar *Calendar, d date.Date) (float64, bool) {
+	return r.Value, !calendar.IsActive(d) && !isWeekend(calendar.DayCounter, d)
+}
+
+// HalfDayWeight is the weight of the half days.
+type HalfDayWeight struct {
+	Value float64
+}
+
+// Weight returns the weight of the input date if it is a half day.
+func (r HalfDayWeight) Weight(calendar *Calendar, d date.Date) (float64, bool) {
+	return r.Value, calendar.Session(d) == HalfDay
+}
+
+// WeekdayWeight is the weight of the active dates falling on a weekday,
+// such as Mondays.
+type WeekdayWeight struct {
+	Weekday time.Weekday
+	Value   float64
+}
+
+// Weight returns the weight of the input date if it is active
+// and falls on the weekday.
+func (r WeekdayWeight) Weight(calendar *Calendar, d date.Date) (float64, bool) {
+	return r.Value, d.Weekday() == r.Weekday && calendar.IsActive(d)
+}
+
+// DatesWeight is the weight of a set of dates, such as event days.
+// It is built by NewDatesWeight.
+type DatesWeight struct {
+	// dates is the sorted list of the dates, so that they are
+	// searched in logarithmic time.
+	dates []date.Date
+	value float64
+}
+
+// NewDatesWeight returns the rule assigning the input weight
+// to the input dates.
+func NewDatesWeight(value float64, dates ...date.Date) DatesWeight {
+	return DatesWeight{
+		dates: sortDates(append([]date.Date{}, dates...)),
+		value: value,
+	}
+}
+
+// Weight returns the weight of the input date if it is one of the dates.
+func (r DatesWeight) Weight(_ *Calendar, d date.Date) (float64, bool) {
+	return r.value, containsDate(r.dates, d)
+}
+
+// WeightedCalendar assigns a weight to each date of a calendar, such as
+// the variance time of volatility models.
+// The weight of a date is given by its override if any, else by the first
+// rule applying to it, else by the weight of its session in the calendar.
+type WeightedCalendar struct {
+	calendar  *Calendar
+	overrides map[date.Date]float64
+	rules     []WeightRule
+	// years caches the total weights of the calendar years.
+	years sync.Map
+}
+
+// NewWeighted returns a weighted calendar based on the input calendar,
+// with weights given by the input overrides and rules.
+func NewWeighted(calendar *Calendar, overrides map[date.Date]float64, rules ...WeightRule) *WeightedCalendar {
+	copied := make(map[date.Date]float64, len(overrides))
+	for d, weight := range overrides {
+		copied[d] = weight
+	}
+
+	return &WeightedCalendar{
+		calendar:  calendar,
+		overrides: copied,
+		rules:     rules,
+	}
+}
+
+// Calendar returns the underlying calendar.
+func (c *WeightedCalendar) Calendar() *Calendar {
+	return c.calendar
+}
+
+// Weight returns the weight of the input date.
+func (c *WeightedCalendar) Weight(d date.Date) float64 {
+	if weight, ok := c.overrides[d]; ok {
+		return weight
+	}
+
+	for _, rule := range c.rules {
+		if weight, ok := rule.Weight(c.calendar, d); ok {
+			return weight
+		}
+	}
+
+	return c.calendar.Weight(d)
+}
+
+// DaysBetween computes the sum of the weights of the dates between
+// from (excluded) and to (included).
+func (c *WeightedCalendar) DaysBetween(from, to date.Date) float64 {
+	if from.After(to) {
+		return -c.DaysBetween(to, from)
+	}
+
+	days := 0.0
+	for current := from.Add(1); !current.After(to); current = current.Add(1) {
+		days += c.Weight(current)
+	}
+
+	return days
+}
+
+// Add returns the first date after the input origin date at which the sum
+// of the weights since origin (excluded) reaches the input target, or the
+// latest date before origin if the target is negative, so that
+// DaysBetween(origin, Add(origin, target)) is at least |target| in absolute
+// value. The origin is returned for a zero target.
+// Dates with positive weights must keep occurring, otherwise Add doesn't return.
+func (c *WeightedCalendar) Add(origin date.Date, target float64) date.Date {
+	shift := 1
+	if target < 0 {
+		shift, target = -1, -target
+	}
+
+	current := origin
+	for sum := 0.0; sum < target; current = current.Add(shift) {
+		// Going backward, the weight of a date counts when moving past it.
+		if shift > 0 {
+			sum += c.Weight(current.Add(1))
+		} else {
+			sum += c.Weight(current)
+		}
+	}
+
+	return current
+}
+
+// YearFraction returns the year fraction between from (excluded) and
+// to (included), where the weights of each calendar year are divided by
+// the total weight of that year, as in ActualYearFraction.
+func (c *WeightedCalendar) YearFraction(from, to date.Date) float64 {
+	return yearFraction(from, to, c.DaysBetween, c.weightInYear)
+}
+
+// weightInYear returns the total weight of the input calendar year.
+func (c *WeightedCalendar) weightInYear(year int) float64 {
+	if cached, ok := c.years.Load(year); ok {
+		if weight, ok := cached.(float64); ok {
+			return weight
+		}
+	}
+
+	weight := c.DaysBetween(date.New(year-1, time.December, 31), date.New(year, time.December, 31))
+	c.years.Store(year, weight)
+
+	return weight
+}
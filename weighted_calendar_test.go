@@ -0,0 +1,127 @@
+package calendar
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/edgelaboratories/date"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_WeightedCalendar_Weight(t *testing.T) {
+	t.Parallel()
+
+	var (
+		event    = date.New(2021, time.November, 3)
+		override = date.New(2021, time.November, 4)
+		calendar = NewWeighted(New(NYSE), map[date.Date]float64{override: 1.5},
+			NewDatesWeight(2, event),
+			HolidayWeight{0.3},
+			WeekendWeight{0.1},
+		)
+	)
+
+	for _, tc := range []struct {
+		name     string
+		date     date.Date
+		expected float64
+	}{
+		{"regular day", date.New(2021, time.November, 2), 1},
+		{"event", event, 2},
+		{"override", override, 1.5},
+		{"weekend", date.New(2021, time.November, 6), 0.1},
+		{"holiday", date.New(2021, time.November, 25), 0.3},
+		{"half day", date.New(2021, time.November, 26), 0.5},
+	} {
+		assert.InDelta(t, tc.expected, calendar.Weight(tc.date), 1e-12, tc.name)
+	}
+
+	assert.Equal(t, NYSE, calendar.Calendar().Convention())
+}
+
+func Test_WeightRules(t *testing.T) {
+	t.Parallel()
+
+	var (
+		calendar = New(NYSE)
+		monday   = date.New(2021, time.November, 22)
+		friday   = date.New(2021, time.November, 26)
+		saturday = date.New(2021, time.November, 27)
+		holiday  = date.New(2021, time.November, 25)
+	)
+
+	for _, tc := range []struct {
+		name    string
+		rule    WeightRule
+		applies []date.Date
+		skips   []date.Date
+	}{
+		{"weekend", WeekendWeight{0.1}, []date.Date{saturday}, []date.Date{monday, holiday, friday}},
+		{"holiday", HolidayWeight{0.1}, []date.Date{holiday}, []date.Date{monday, saturday, friday}},
+		{"half day", HalfDayWeight{0.1}, []date.Date{friday}, []date.Date{monday, saturday, holiday}},
+		{"weekday", WeekdayWeight{time.Monday, 0.1}, []date.Date{monday}, []date.Date{saturday, holiday, friday}},
+		{"dates", NewDatesWeight(0.1, saturday, holiday, saturday), []date.Date{holiday, saturday}, []date.Date{monday, friday}},
+	} {
+		for _, d := range tc.applies {
+			weight, ok := tc.rule.Weight(calendar, d)
+			assert.True(t, ok, "%s %s", tc.name, d)
+			assert.InDelta(t, 0.1, weight, 0, "%s %s", tc.name, d)
+		}
+
+		for _, d := range tc.skips {
+			_, ok := tc.rule.Weight(calendar, d)
+			assert.False(t, ok, "%s %s", tc.name, d)
+		}
+	}
+}
+
+func Test_WeightedCalendar_DaysBetween_Add(t *testing.T) {
+	t.Parallel()
+
+	var (
+		calendar = NewWeighted(New(BusinessDays), nil, WeekendWeight{0.25})
+		friday   = date.New(2021, time.November, 5)
+		monday   = date.New(2021, time.November, 8)
+	)
+
+	assert.InDelta(t, 1.5, calendar.DaysBetween(friday, monday), 1e-12)
+	assert.InDelta(t, -1.5, calendar.DaysBetween(monday, friday), 1e-12)
+	assert.InDelta(t, 0.0, calendar.DaysBetween(friday, friday), 0)
+	assert.InDelta(t, 5.5, calendar.DaysBetween(friday, friday.Add(7)), 1e-12)
+
+	for _, tc := range []struct {
+		origin   date.Date
+		target   float64
+		expected date.Date
+	}{
+		{friday, 0, friday},
+		{friday, 0.25, friday.Add(1)},
+		{friday, 0.3, friday.Add(2)},
+		{friday, 1.5, monday},
+		{friday, 2, monday.Add(1)},
+		{monday, -1, friday.Add(2)},
+		{monday, -1.5, friday},
+		{monday, -1.6, friday.Add(-1)},
+	} {
+		actual := calendar.Add(tc.origin, tc.target)
+		assert.Equal(t, tc.expected, actual, "%s %f", tc.origin, tc.target)
+
+		// The target is reached.
+		assert.GreaterOrEqual(t, math.Abs(calendar.DaysBetween(tc.origin, actual)), math.Abs(tc.target)-1e-12)
+	}
+}
+
+func Test_WeightedCalendar_YearFraction(t *testing.T) {
+	t.Parallel()
+
+	calendar := NewWeighted(New(BusinessDays), nil, WeekendWeight{0.5})
+
+	// 2021 has 261 weekdays and 104 weekend days.
+	assert.InDelta(t, 1.0, calendar.YearFraction(date.New(2020, time.December, 31), date.New(2021, time.December, 31)), 1e-12)
+	assert.InDelta(t, 2.0/313, calendar.YearFraction(date.New(2021, time.November, 5), date.New(2021, time.November, 8)), 1e-12)
+	assert.InDelta(t, -2.0/313, calendar.YearFraction(date.New(2021, time.November, 8), date.New(2021, time.November, 5)), 1e-12)
+
+	// 2022 has 260 weekdays and 105 weekend days.
+	assert.InDelta(t, 1.0/313+2.0/312.5, calendar.YearFraction(date.New(2021, time.December, 30), date.New(2022, time.January, 3)), 1e-12)
+}
@@ -0,0 +1,68 @@
+package calendar
+
+import (
+	"time"
+
+	"github.com/edgelaboratories/date"
+)
+
+// YearFraction returns the number of active days between from (excluded)
+// and to (included), divided by the standard year duration of the calendar.
+// For calendars with the ActualBasis, ActualYearFraction is returned instead.
+// The year fraction is negative when from is after to.
+func (c *Calendar) YearFraction(from, to date.Date) float64 {
+	if hasActualBasis(c.DayCounter) {
+		return c.ActualYearFraction(from, to)
+	}
+
+	return float64(c.DaysBetween(from, to)) / float64(c.DaysInYear())
+}
+
+// ActualYearFraction returns the year fraction between from (excluded)
+// and to (included), where the active days of each calendar year are
+// divided by the actual number of active days in that year.
+// The year fraction is negative when from is after to.
+func (c *Calendar) ActualYearFraction(from, to date.Date) float64 {
+	return yearFraction(from, to, func(start, end date.Date) float64 {
+		return float64(c.DaysBetween(start, end))
+	}, func(year int) float64 {
+		return float64(c.DaysInCalendarYear(year))
+	})
+}
+
+// yearFraction returns the year fraction between from (excluded) and
+// to (included), where the days of each calendar year, counted by the
+// count function, are divided by the total of that year.
+// The year fraction is negative when from is after to.
+func yearFraction(from, to date.Date, count func(from, to date.Date) float64, total func(year int) float64) float64 {
+	if from.After(to) {
+		return -yearFraction(to, from, count, total)
+	}
+
+	fraction := 0.0
+
+	for start := from; start.Before(to); {
+		// The days between start (excluded) and the end of the
+		// period (included) all belong to the year of the next date.
+		year := start.Add(1).Year()
+
+		end := date.New(year, time.December, 31)
+		if end.After(to) {
+			end = to
+		}
+
+		if days := count(start, end); days != 0 {
+			fraction += days / total(year)
+		}
+
+		start = end
+	}
+
+	return fraction
+}
+
+// DaysInCalendarYear returns the actual number of active days in the
+// input calendar year, as opposed to the standard year duration.
+func (c *Calendar) DaysInCalendarYear(year int) int {
+	return c.DaysBetween(date.New(year-1, time.December, 31), date.New(year, time.December, 31))
+}
@@ -0,0 +1,150 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgelaboratories/date"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Calendar_YearFraction(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		calendar *Calendar
+		from     date.Date
+		to       date.Date
+		expected float64
+	}{
+		{
+			"calendar days",
+			New(CalendarDays),
+			date.New(2021, time.January, 1),
+			date.New(2022, time.January, 1),
+			1,
+		},
+		{
+			"business days",
+			New(BusinessDays),
+			date.New(2021, time.October, 18),
+			date.New(2021, time.October, 25),
+			5.0 / 252,
+		},
+		{
+			"negative",
+			New(BusinessDays),
+			date.New(2021, time.October, 25),
+			date.New(2021, time.October, 18),
+			-5.0 / 252,
+		},
+		{
+			"same date",
+			New(NYSE),
+			date.New(2021, time.October, 25),
+			date.New(2021, time.October, 25),
+			0,
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.InDelta(t, tc.expected, tc.calendar.YearFraction(tc.from, tc.to), 1e-12)
+		})
+	}
+}
+
+func Test_Calendar_ActualYearFraction(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		calendar *Calendar
+		from     date.Date
+		to       date.Date
+		expected float64
+	}{
+		{
+			"leap year",
+			New(CalendarDays),
+			date.New(2019, time.December, 31),
+			date.New(2020, time.December, 31),
+			1,
+		},
+		{
+			"across years",
+			New(CalendarDays),
+			date.New(2020, time.July, 1),
+			date.New(2021, time.July, 1),
+			183.0/366 + 182.0/365,
+		},
+		{
+			"negative",
+			New(CalendarDays),
+			date.New(2021, time.July, 1),
+			date.New(2020, time.July, 1),
+			-183.0/366 - 182.0/365,
+		},
+		{
+			// 2021 has 261 weekdays, 2022 has 260 weekdays.
+			"business days",
+			New(BusinessDays),
+			date.New(2021, time.December, 24),
+			date.New(2022, time.January, 7),
+			5.0/261 + 5.0/260,
+		},
+		{
+			"from the last day of a year",
+			New(BusinessDays),
+			date.New(2021, time.December, 31),
+			date.New(2022, time.December, 31),
+			1,
+		},
+		{
+			"same date",
+			New(BusinessDays),
+			date.New(2021, time.December, 31),
+			date.New(2021, time.December, 31),
+			0,
+		},
+		{
+			// NYSE had 252 trading days in 2021.
+			"market",
+			New(NYSE),
+			date.New(2020, time.December, 31),
+			date.New(2021, time.July, 1),
+			float64(New(NYSE).DaysBetween(date.New(2020, time.December, 31), date.New(2021, time.July, 1))) / 252,
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.InDelta(t, tc.expected, tc.calendar.ActualYearFraction(tc.from, tc.to), 1e-12)
+		})
+	}
+}
+
+func Test_Calendar_DaysInCalendarYear(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		convention Convention
+		year       int
+		expected   int
+	}{
+		{CalendarDays, 2020, 366},
+		{CalendarDays, 2021, 365},
+		{BusinessDays, 2020, 262},
+		{BusinessDays, 2021, 261},
+		{BusinessDays, 2022, 260},
+		{NYSE, 2021, 252},
+		{NYSE, 2022, 251},
+	} {
+		assert.Equal(t, tc.expected, New(tc.convention).DaysInCalendarYear(tc.year), "%s %d", tc.convention, tc.year)
+	}
+}